@@ -2,17 +2,14 @@ package client
 
 import (
 	"context"
-	"os"
 	"testing"
 
-	"github.com/rs/zerolog"
 	"github.com/stretchr/testify/require"
 )
 
 func TestApiResource(t *testing.T) {
 	ctx := context.Background()
-	config := DefaultConfig()
-	config.Logger = zerolog.New(os.Stdout)
+	config := newTestConfig(t)
 	client, err := NewClient(config)
 	require.NoError(t, err)
 
@@ -4,6 +4,8 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"net/url"
+	"path"
 )
 
 func (c *Client) ApplicationGet(ctx context.Context, id string) (*ApplicationModel, error) {
@@ -31,6 +33,25 @@ func (c *Client) ApplicationGet(ctx context.Context, id string) (*ApplicationMod
 	return &application, nil
 }
 
+func (c *Client) ApplicationList(ctx context.Context, query_params map[string]string) ([]ApplicationModel, error) {
+	req := &request{
+		method:          http.MethodGet,
+		path:            "api/applications",
+		queryParameters: query_params,
+	}
+
+	res, err := expect(200)(c.do(ctx, req))
+	if err != nil {
+		return nil, err
+	}
+
+	var applications []ApplicationModel
+	if err := decode(res.Body, &applications); err != nil {
+		return nil, err
+	}
+	return applications, nil
+}
+
 func (c *Client) ApplicationCreate(ctx context.Context, app *ApplicationModel) (*ApplicationModel, error) {
 	req := &request{
 		method: http.MethodPost,
@@ -86,6 +107,8 @@ func (c *Client) ApplicationUpdate(ctx context.Context, app *ApplicationModel) (
 	return &application, nil
 }
 
+// GetApplicationSecrets returns the secrets of the application, or nil if the
+// application does not exist.
 func (c *Client) GetApplicationSecrets(ctx context.Context, id string) ([]Secret, error) {
 	if id == "" {
 		return nil, errEmptyID
@@ -96,14 +119,178 @@ func (c *Client) GetApplicationSecrets(ctx context.Context, id string) ([]Secret
 		path:   fmt.Sprintf("api/applications/%s/secrets", id),
 	}
 
-	res, err := expect(200)(c.do(ctx, req))
+	res, err := expect(200, 404)(c.do(ctx, req))
 	if err != nil {
 		return nil, err
 	}
 
-	var secrets []Secret
+	if res.StatusCode == 404 {
+		return nil, nil
+	}
+
+	secrets := []Secret{}
 	if err := decode(res.Body, &secrets); err != nil {
 		return nil, err
 	}
 	return secrets, nil
 }
+
+func (c *Client) ApplicationSecretCreate(ctx context.Context, applicationId string, secret *Secret) (*Secret, error) {
+	if applicationId == "" {
+		return nil, errEmptyID
+	}
+
+	req := &request{
+		method: http.MethodPost,
+		path:   path.Join("api/applications", applicationId, "secrets"),
+		body:   secret,
+	}
+
+	res, err := expect(201)(c.do(ctx, req))
+	if err != nil {
+		return nil, err
+	}
+
+	var returnSecret Secret
+	if err := decode(res.Body, &returnSecret); err != nil {
+		return nil, err
+	}
+	return &returnSecret, nil
+}
+
+// ApplicationSecretUpdate renames the secret called name.
+func (c *Client) ApplicationSecretUpdate(ctx context.Context, applicationId string, name string, secret *Secret) (*Secret, error) {
+	if applicationId == "" || name == "" {
+		return nil, errEmptyID
+	}
+
+	req := &request{
+		method: http.MethodPatch,
+		path:   path.Join("api/applications", applicationId, "secrets", url.PathEscape(name)),
+		body:   secret,
+	}
+
+	res, err := expect(200)(c.do(ctx, req))
+	if err != nil {
+		return nil, err
+	}
+
+	var returnSecret Secret
+	if err := decode(res.Body, &returnSecret); err != nil {
+		return nil, err
+	}
+	return &returnSecret, nil
+}
+
+func (c *Client) ApplicationSecretDelete(ctx context.Context, applicationId string, name string) error {
+	if applicationId == "" || name == "" {
+		return errEmptyID
+	}
+
+	req := &request{
+		method: http.MethodDelete,
+		path:   path.Join("api/applications", applicationId, "secrets", url.PathEscape(name)),
+	}
+
+	_, err := expect(204)(c.do(ctx, req))
+	return err
+}
+
+// ApplicationCustomDomainAdd adds a custom domain to a protected app. The
+// domain must then be verified by adding the DNS records returned by Logto.
+func (c *Client) ApplicationCustomDomainAdd(ctx context.Context, applicationId string, domain string) error {
+	if applicationId == "" {
+		return errEmptyID
+	}
+
+	req := &request{
+		method: http.MethodPost,
+		path:   path.Join("api/applications", applicationId, "protected-app-metadata/custom-domains"),
+		body:   map[string]string{"domain": domain},
+	}
+
+	_, err := expect(201)(c.do(ctx, req))
+	return err
+}
+
+func (c *Client) ApplicationCustomDomainRemove(ctx context.Context, applicationId string, domain string) error {
+	if applicationId == "" || domain == "" {
+		return errEmptyID
+	}
+
+	req := &request{
+		method: http.MethodDelete,
+		path:   path.Join("api/applications", applicationId, "protected-app-metadata/custom-domains", url.PathEscape(domain)),
+	}
+
+	_, err := expect(204)(c.do(ctx, req))
+	return err
+}
+
+func (c *Client) GetRolesForApplication(ctx context.Context, applicationId string) ([]RoleModel, error) {
+	if applicationId == "" {
+		return nil, errEmptyID
+	}
+
+	req := &request{
+		method: http.MethodGet,
+		path:   path.Join("api/applications", applicationId, "roles"),
+	}
+
+	res, err := expect(200)(c.do(ctx, req))
+	if err != nil {
+		return nil, err
+	}
+
+	var roles []RoleModel
+	if err := decode(res.Body, &roles); err != nil {
+		return nil, err
+	}
+	return roles, nil
+}
+
+func (c *Client) AssignRolesForApplication(ctx context.Context, roleIds *RoleIdsModel, applicationId string) error {
+	if applicationId == "" || len(roleIds.RoleIds) == 0 {
+		return errEmptyID
+	}
+
+	req := &request{
+		method: http.MethodPost,
+		path:   path.Join("api/applications", applicationId, "roles"),
+		body:   roleIds,
+	}
+
+	_, err := expect(201)(c.do(ctx, req))
+	return err
+}
+
+// UpdateRolesForApplication replaces the roles of the application, an empty
+// list removes all of them.
+func (c *Client) UpdateRolesForApplication(ctx context.Context, roleIds *RoleIdsModel, applicationId string) error {
+	if applicationId == "" {
+		return errEmptyID
+	}
+
+	req := &request{
+		method: http.MethodPut,
+		path:   path.Join("api/applications", applicationId, "roles"),
+		body:   roleIds,
+	}
+
+	_, err := expect(200)(c.do(ctx, req))
+	return err
+}
+
+func (c *Client) DeleteRolesForApplication(ctx context.Context, roleId string, applicationId string) error {
+	if applicationId == "" || roleId == "" {
+		return errEmptyID
+	}
+
+	req := &request{
+		method: http.MethodDelete,
+		path:   path.Join("api/applications", applicationId, "roles", roleId),
+	}
+
+	_, err := expect(204)(c.do(ctx, req))
+	return err
+}
@@ -0,0 +1,58 @@
+package client
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestApplicationSecret(t *testing.T) {
+	ctx := context.Background()
+	config := newTestConfig(t)
+	client, err := NewClient(config)
+	require.NoError(t, err)
+
+	app, err := client.ApplicationCreate(ctx, &ApplicationModel{
+		Name: "test_application_secret",
+		Type: "MachineToMachine",
+	})
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, client.ApplicationDelete(ctx, app.ID))
+	}()
+
+	expiresAt := float64(time.Now().Add(24 * time.Hour).UnixMilli())
+	secret, err := client.ApplicationSecretCreate(ctx, app.ID, &Secret{
+		Name:      "test secret",
+		ExpiresAt: &expiresAt,
+	})
+	require.NoError(t, err)
+	require.Equal(t, "test secret", secret.Name)
+	require.Equal(t, app.ID, secret.ApplicationId)
+	require.NotEmpty(t, secret.Value)
+	require.NotNil(t, secret.CreatedAt)
+	require.NotNil(t, secret.ExpiresAt)
+	require.Equal(t, expiresAt, *secret.ExpiresAt)
+
+	secrets, err := client.GetApplicationSecrets(ctx, app.ID)
+	require.NoError(t, err)
+	require.Len(t, secrets, 2)
+
+	secret, err = client.ApplicationSecretUpdate(ctx, app.ID, "test secret", &Secret{Name: "test secret renamed"})
+	require.NoError(t, err)
+	require.Equal(t, "test secret renamed", secret.Name)
+
+	err = client.ApplicationSecretDelete(ctx, app.ID, "test secret renamed")
+	require.NoError(t, err)
+
+	secrets, err = client.GetApplicationSecrets(ctx, app.ID)
+	require.NoError(t, err)
+	require.Len(t, secrets, 1)
+	require.Equal(t, "Default secret", secrets[0].Name)
+
+	secrets, err = client.GetApplicationSecrets(ctx, "unknown")
+	require.NoError(t, err)
+	require.Nil(t, secrets)
+}
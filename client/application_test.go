@@ -2,17 +2,15 @@ package client
 
 import (
 	"context"
-	"os"
+	"encoding/json"
 	"testing"
 
-	"github.com/rs/zerolog"
 	"github.com/stretchr/testify/require"
 )
 
 func TestApplication(t *testing.T) {
 	ctx := context.Background()
-	config := DefaultConfig()
-	config.Logger = zerolog.New(os.Stdout)
+	config := newTestConfig(t)
 	client, err := NewClient(config)
 	require.NoError(t, err)
 
@@ -26,7 +24,7 @@ func TestApplication(t *testing.T) {
 		Type:                 "Traditional",
 		OidcClientMetadata:   &OidcClientMetadata{RedirectUris: []string{}, PostLogoutRedirectUris: []string{}},
 		CustomClientMetadata: &CustomClientMetadata{},
-		CustomData:           map[string]interface{}{},
+		CustomData:           json.RawMessage(`{}`),
 		ProtectedAppMetadata: nil,
 		IsAdmin:              false,
 		IsThirdParty:         true,
@@ -56,6 +54,10 @@ func TestApplication(t *testing.T) {
 	require.Equal(t, "test", app.Name)
 	require.Equal(t, "Traditional", app.Type)
 
+	apps, err := client.ApplicationList(ctx, nil)
+	require.NoError(t, err)
+	require.NotEmpty(t, apps)
+
 	secrets, err := client.GetApplicationSecrets(ctx, appId)
 	require.NoError(t, err)
 	require.Len(t, secrets, 1)
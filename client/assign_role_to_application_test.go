@@ -0,0 +1,98 @@
+package client
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestAssignRoleToApplication(t *testing.T) {
+	ctx := context.Background()
+	config := newTestConfig(t)
+	client, err := NewClient(config)
+	require.NoError(t, err)
+
+	// Initialize objects for testing
+	app, err := client.ApplicationCreate(ctx, &ApplicationModel{
+		Name: "clientTestAssignRole",
+		Type: "MachineToMachine",
+	})
+	require.NoError(t, err)
+	require.NotEmpty(t, app.ID)
+
+	role, err := client.RoleCreate(ctx, &RoleModel{
+		Name:        "clientTestAssignRoleToApplication",
+		Description: "A role to test the assignation with an application",
+		Type:        "MachineToMachine",
+	})
+	require.NoError(t, err)
+	require.NotEmpty(t, role.ID)
+
+	role1, err := client.RoleCreate(ctx, &RoleModel{
+		Name:        "clientTestAssignRoleToApplication1",
+		Description: "A role to test the assignation with an application",
+		Type:        "MachineToMachine",
+	})
+	require.NoError(t, err)
+	require.NotEmpty(t, role1.ID)
+
+	// Check that association works
+	err = client.AssignRolesForApplication(ctx, &RoleIdsModel{
+		RoleIds: []string{
+			role.ID,
+		},
+	}, app.ID)
+	require.NoError(t, err)
+
+	roles, err := client.GetRolesForApplication(ctx, app.ID)
+	require.NoError(t, err)
+	require.Len(t, roles, 1)
+	require.Equal(t, role.ID, roles[0].ID)
+
+	// Check that update works
+	err = client.UpdateRolesForApplication(ctx, &RoleIdsModel{
+		RoleIds: []string{
+			role1.ID,
+		},
+	}, app.ID)
+	require.NoError(t, err)
+
+	roles, err = client.GetRolesForApplication(ctx, app.ID)
+	require.NoError(t, err)
+	require.Len(t, roles, 1)
+	require.Equal(t, role1.ID, roles[0].ID)
+
+	// Check that deletion works
+	err = client.DeleteRolesForApplication(ctx, role1.ID, app.ID)
+	require.NoError(t, err)
+
+	roles, err = client.GetRolesForApplication(ctx, app.ID)
+	require.NoError(t, err)
+	require.Empty(t, roles)
+
+	// Check that an empty update removes every role
+	err = client.AssignRolesForApplication(ctx, &RoleIdsModel{
+		RoleIds: []string{
+			role.ID,
+		},
+	}, app.ID)
+	require.NoError(t, err)
+
+	err = client.UpdateRolesForApplication(ctx, &RoleIdsModel{RoleIds: []string{}}, app.ID)
+	require.NoError(t, err)
+
+	roles, err = client.GetRolesForApplication(ctx, app.ID)
+	require.NoError(t, err)
+	require.Empty(t, roles)
+
+	// Remove roles and application
+	err = client.RoleDelete(ctx, role.ID)
+	require.NoError(t, err)
+
+	err = client.RoleDelete(ctx, role1.ID)
+	require.NoError(t, err)
+
+	err = client.ApplicationDelete(ctx, app.ID)
+	require.NoError(t, err)
+}
@@ -2,17 +2,14 @@ package client
 
 import (
 	"context"
-	"os"
 	"testing"
 
-	"github.com/rs/zerolog"
 	"github.com/stretchr/testify/require"
 )
 
 func TestAssignRoleToUser(t *testing.T) {
 	ctx := context.Background()
-	config := DefaultConfig()
-	config.Logger = zerolog.New(os.Stdout)
+	config := newTestConfig(t)
 	client, err := NewClient(config)
 	require.NoError(t, err)
 
@@ -55,6 +52,21 @@ func TestAssignRoleToUser(t *testing.T) {
 	require.NotEmpty(t, roles)
 	require.Equal(t, roleId, roles[0].ID)
 
+	// Check that the roles can be listed page by page
+	err = client.AssignRolesForUser(ctx, &RoleIdsModel{
+		RoleIds: []string{
+			role1.ID,
+		},
+	}, user.ID)
+	require.NoError(t, err)
+
+	var ids []string
+	for role, err := range client.UserRolesAll(ctx, user.ID, map[string]string{"page_size": "1"}) {
+		require.NoError(t, err)
+		ids = append(ids, role.ID)
+	}
+	require.ElementsMatch(t, []string{roleId, roleId1}, ids)
+
 	// Check that update works
 	err = client.UpdateRolesForUser(ctx, &RoleIdsModel{
 		RoleIds: []string{
@@ -0,0 +1,277 @@
+package client
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/sha512"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// Authenticator obtains the access tokens used to call the Management API.
+type Authenticator interface {
+	// AccessToken returns a new access token and how long it is valid for.
+	// A zero duration means the token is used until Logto rejects it.
+	AccessToken(ctx context.Context, endpoint *TokenEndpoint) (string, time.Duration, error)
+}
+
+// TokenEndpoint is the OIDC token endpoint of the Logto tenant.
+type TokenEndpoint struct {
+	// URL is the address of the endpoint, it is the audience of client
+	// assertions.
+	URL string
+
+	client *Client
+}
+
+// ClientCredentials exchanges the credentials of the application for an
+// access token to the Management API, using the client credentials grant.
+// params are added to the form sent to the endpoint and applicationID and
+// applicationSecret, when set, are sent using basic authentication.
+func (e *TokenEndpoint) ClientCredentials(ctx context.Context, params url.Values, applicationID, applicationSecret string) (string, time.Duration, error) {
+	data := url.Values{}
+	data.Set("grant_type", "client_credentials")
+	data.Set("resource", e.client.conf.Resource)
+	data.Set("scope", "all")
+	for key, values := range params {
+		data[key] = values
+	}
+
+	req := &request{
+		method:             "POST",
+		path:               "oidc/token",
+		application_id:     applicationID,
+		application_secret: applicationSecret,
+		unauthenticated:    true,
+		rawBody:            strings.NewReader(data.Encode()),
+		headers: map[string]string{
+			"Content-Type": "application/x-www-form-urlencoded",
+		},
+	}
+
+	resp, err := expect(200)(e.client.do(ctx, req))
+	if err != nil {
+		return "", 0, err
+	}
+	defer resp.Body.Close()
+
+	type Response struct {
+		AccessToken string `json:"access_token"`
+		ExpiresIn   int    `json:"expires_in"`
+		TokenType   string `json:"token_type"`
+		Scope       string `json:"scope"`
+	}
+
+	var decodedResponse Response
+	dec := json.NewDecoder(resp.Body)
+	if err := dec.Decode(&decodedResponse); err != nil {
+		return "", 0, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	if decodedResponse.TokenType != tokenType {
+		return "", 0, fmt.Errorf("unexpected token type %q, expected %q", decodedResponse.TokenType, tokenType)
+	}
+
+	return decodedResponse.AccessToken, time.Duration(decodedResponse.ExpiresIn) * time.Second, nil
+}
+
+// ClientSecret authenticates the application with its secret, sent using
+// basic authentication.
+type ClientSecret struct {
+	ApplicationID     string
+	ApplicationSecret string
+}
+
+func (a *ClientSecret) AccessToken(ctx context.Context, endpoint *TokenEndpoint) (string, time.Duration, error) {
+	return endpoint.ClientCredentials(ctx, nil, a.ApplicationID, a.ApplicationSecret)
+}
+
+// PrivateKeyJWT authenticates the application with a client assertion signed
+// by its private key, as described by the private_key_jwt method of OpenID
+// Connect.
+type PrivateKeyJWT struct {
+	ApplicationID string
+
+	// Key is either an *rsa.PrivateKey, an *ecdsa.PrivateKey or an
+	// ed25519.PrivateKey, as returned by ParsePrivateKey.
+	Key crypto.Signer
+
+	// KeyID is set as the kid header of the assertion when not empty.
+	KeyID string
+}
+
+func (a *PrivateKeyJWT) AccessToken(ctx context.Context, endpoint *TokenEndpoint) (string, time.Duration, error) {
+	assertion, err := a.assertion(endpoint.URL, time.Now())
+	if err != nil {
+		return "", 0, fmt.Errorf("failed to sign client assertion: %w", err)
+	}
+
+	params := url.Values{}
+	params.Set("client_id", a.ApplicationID)
+	params.Set("client_assertion_type", "urn:ietf:params:oauth:client-assertion-type:jwt-bearer")
+	params.Set("client_assertion", assertion)
+	return endpoint.ClientCredentials(ctx, params, "", "")
+}
+
+// assertion returns the signed JWT identifying the application to audience.
+func (a *PrivateKeyJWT) assertion(audience string, now time.Time) (string, error) {
+	algorithm, err := signingAlgorithm(a.Key)
+	if err != nil {
+		return "", err
+	}
+
+	jti := make([]byte, 16)
+	if _, err := rand.Read(jti); err != nil {
+		return "", err
+	}
+
+	header := map[string]string{
+		"alg": algorithm,
+		"typ": "JWT",
+	}
+	if a.KeyID != "" {
+		header["kid"] = a.KeyID
+	}
+	claims := map[string]any{
+		"iss": a.ApplicationID,
+		"sub": a.ApplicationID,
+		"aud": audience,
+		"jti": hex.EncodeToString(jti),
+		"iat": now.Unix(),
+		"exp": now.Add(time.Minute).Unix(),
+	}
+
+	var parts []string
+	for _, part := range []any{header, claims} {
+		encoded, err := json.Marshal(part)
+		if err != nil {
+			return "", err
+		}
+		parts = append(parts, base64.RawURLEncoding.EncodeToString(encoded))
+	}
+
+	signature, err := sign(a.Key, []byte(strings.Join(parts, ".")))
+	if err != nil {
+		return "", err
+	}
+	parts = append(parts, base64.RawURLEncoding.EncodeToString(signature))
+
+	return strings.Join(parts, "."), nil
+}
+
+func signingAlgorithm(key crypto.Signer) (string, error) {
+	switch key := key.(type) {
+	case *rsa.PrivateKey:
+		return "RS256", nil
+	case *ecdsa.PrivateKey:
+		switch key.Curve {
+		case elliptic.P256():
+			return "ES256", nil
+		case elliptic.P384():
+			return "ES384", nil
+		case elliptic.P521():
+			return "ES512", nil
+		}
+		return "", fmt.Errorf("unsupported elliptic curve %s", key.Curve.Params().Name)
+	case ed25519.PrivateKey:
+		return "EdDSA", nil
+	}
+	return "", fmt.Errorf("unsupported private key type %T", key)
+}
+
+func sign(key crypto.Signer, payload []byte) ([]byte, error) {
+	switch key := key.(type) {
+	case *rsa.PrivateKey:
+		digest := sha256.Sum256(payload)
+		return rsa.SignPKCS1v15(rand.Reader, key, crypto.SHA256, digest[:])
+
+	case *ecdsa.PrivateKey:
+		var digest []byte
+		switch key.Curve {
+		case elliptic.P256():
+			sum := sha256.Sum256(payload)
+			digest = sum[:]
+		case elliptic.P384():
+			sum := sha512.Sum384(payload)
+			digest = sum[:]
+		default:
+			sum := sha512.Sum512(payload)
+			digest = sum[:]
+		}
+		r, s, err := ecdsa.Sign(rand.Reader, key, digest)
+		if err != nil {
+			return nil, err
+		}
+
+		// JWS uses the fixed size concatenation of r and s rather than
+		// the ASN.1 encoding.
+		size := (key.Curve.Params().BitSize + 7) / 8
+		signature := make([]byte, 2*size)
+		r.FillBytes(signature[:size])
+		s.FillBytes(signature[size:])
+		return signature, nil
+
+	case ed25519.PrivateKey:
+		return ed25519.Sign(key, payload), nil
+	}
+	return nil, fmt.Errorf("unsupported private key type %T", key)
+}
+
+// ParsePrivateKey parses a PEM encoded RSA, ECDSA or Ed25519 private key, in
+// either the PKCS #8, PKCS #1 or SEC 1 format.
+func ParsePrivateKey(data []byte) (crypto.Signer, error) {
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, errors.New("no PEM encoded private key found")
+	}
+
+	var key any
+	var err error
+	switch block.Type {
+	case "RSA PRIVATE KEY":
+		key, err = x509.ParsePKCS1PrivateKey(block.Bytes)
+	case "EC PRIVATE KEY":
+		key, err = x509.ParseECPrivateKey(block.Bytes)
+	default:
+		key, err = x509.ParsePKCS8PrivateKey(block.Bytes)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse private key: %w", err)
+	}
+
+	signer, ok := key.(crypto.Signer)
+	if !ok {
+		return nil, fmt.Errorf("unsupported private key type %T", key)
+	}
+	if _, err := signingAlgorithm(signer); err != nil {
+		return nil, err
+	}
+	return signer, nil
+}
+
+// StaticToken authenticates with an access token obtained beforehand, for
+// example by a CI pipeline. The token is not renewed.
+type StaticToken struct {
+	Token string
+}
+
+func (a *StaticToken) AccessToken(ctx context.Context, endpoint *TokenEndpoint) (string, time.Duration, error) {
+	if a.Token == "" {
+		return "", 0, errors.New("missing access token")
+	}
+	return a.Token, 0, nil
+}
@@ -0,0 +1,178 @@
+package client
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// verifyAssertion checks the signature of the JWT and returns its header and
+// claims.
+func verifyAssertion(t *testing.T, public crypto.PublicKey, assertion string) (map[string]any, map[string]any) {
+	t.Helper()
+
+	parts := strings.Split(assertion, ".")
+	require.Len(t, parts, 3)
+
+	var header, claims map[string]any
+	for i, out := range []*map[string]any{&header, &claims} {
+		decoded, err := base64.RawURLEncoding.DecodeString(parts[i])
+		require.NoError(t, err)
+		require.NoError(t, json.Unmarshal(decoded, out))
+	}
+
+	signature, err := base64.RawURLEncoding.DecodeString(parts[2])
+	require.NoError(t, err)
+	payload := []byte(parts[0] + "." + parts[1])
+	digest := sha256.Sum256(payload)
+
+	switch public := public.(type) {
+	case *rsa.PublicKey:
+		require.NoError(t, rsa.VerifyPKCS1v15(public, crypto.SHA256, digest[:], signature))
+	case *ecdsa.PublicKey:
+		r := new(big.Int).SetBytes(signature[:len(signature)/2])
+		s := new(big.Int).SetBytes(signature[len(signature)/2:])
+		require.True(t, ecdsa.Verify(public, digest[:], r, s))
+	case ed25519.PublicKey:
+		require.True(t, ed25519.Verify(public, payload, signature))
+	default:
+		t.Fatalf("unexpected key type %T", public)
+	}
+
+	return header, claims
+}
+
+func TestPrivateKeyJWT(t *testing.T) {
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	ecKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	_, edKey, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+
+	tests := []struct {
+		key       crypto.Signer
+		algorithm string
+	}{
+		{rsaKey, "RS256"},
+		{ecKey, "ES256"},
+		{edKey, "EdDSA"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.algorithm, func(t *testing.T) {
+			// The key goes through the PEM encoding the provider reads
+			der, err := x509.MarshalPKCS8PrivateKey(tt.key)
+			require.NoError(t, err)
+			key, err := ParsePrivateKey(pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der}))
+			require.NoError(t, err)
+
+			var audience string
+			server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Path == "/oidc/token" {
+					_, _, hasBasicAuth := r.BasicAuth()
+					require.False(t, hasBasicAuth)
+					require.NoError(t, r.ParseForm())
+					require.Equal(t, "client_credentials", r.PostForm.Get("grant_type"))
+					require.Equal(t, "app", r.PostForm.Get("client_id"))
+					require.Equal(t, "urn:ietf:params:oauth:client-assertion-type:jwt-bearer", r.PostForm.Get("client_assertion_type"))
+
+					header, claims := verifyAssertion(t, tt.key.Public(), r.PostForm.Get("client_assertion"))
+					require.Equal(t, tt.algorithm, header["alg"])
+					require.Equal(t, "key", header["kid"])
+					require.Equal(t, "app", claims["iss"])
+					require.Equal(t, "app", claims["sub"])
+					require.Equal(t, audience, claims["aud"])
+					require.NotEmpty(t, claims["jti"])
+					require.Greater(t, claims["exp"], claims["iat"])
+
+					_, _ = w.Write([]byte(`{"access_token":"token","expires_in":3600,"token_type":"Bearer"}`))
+					return
+				}
+				require.Equal(t, "Bearer token", r.Header.Get("Authorization"))
+				_, _ = w.Write([]byte(`{"id":"id"}`))
+			}))
+			defer server.Close()
+			audience = fmt.Sprintf("https://%s/oidc/token", server.Listener.Addr())
+
+			client, err := NewClient(&Config{
+				Hostname:   server.Listener.Addr().String(),
+				HttpClient: server.Client(),
+				Authenticator: &PrivateKeyJWT{
+					ApplicationID: "app",
+					Key:           key,
+					KeyID:         "key",
+				},
+			})
+			require.NoError(t, err)
+
+			_, err = client.RoleGet(context.Background(), "id")
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestParsePrivateKey(t *testing.T) {
+	_, err := ParsePrivateKey([]byte("not a key"))
+	require.ErrorContains(t, err, "no PEM encoded private key found")
+
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	key, err := ParsePrivateKey(pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(rsaKey)}))
+	require.NoError(t, err)
+	require.True(t, rsaKey.Equal(key))
+
+	ecKey, err := ecdsa.GenerateKey(elliptic.P384(), rand.Reader)
+	require.NoError(t, err)
+	der, err := x509.MarshalECPrivateKey(ecKey)
+	require.NoError(t, err)
+	key, err = ParsePrivateKey(pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: der}))
+	require.NoError(t, err)
+	require.True(t, ecKey.Equal(key))
+
+	_, err = ParsePrivateKey(pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: []byte("garbage")}))
+	require.ErrorContains(t, err, "failed to parse private key")
+}
+
+func TestStaticToken(t *testing.T) {
+	ctx := context.Background()
+	server := newTokenServer(t, 3600)
+	server.valid["static"] = true
+
+	client, err := NewClient(&Config{
+		Hostname:      server.Listener.Addr().String(),
+		HttpClient:    server.Client(),
+		Authenticator: &StaticToken{Token: "static"},
+	})
+	require.NoError(t, err)
+
+	for range 3 {
+		_, err = client.RoleGet(ctx, "id")
+		require.NoError(t, err)
+	}
+
+	// A rejected token is not renewed
+	server.revoke()
+	_, err = client.RoleGet(ctx, "id")
+	require.ErrorContains(t, err, "401")
+
+	issued, apiCalls := server.counts()
+	require.Zero(t, issued)
+	require.Equal(t, 5, apiCalls)
+}
@@ -11,8 +11,6 @@ import (
 	"net/url"
 	"os"
 	"slices"
-	"strings"
-	"sync"
 	"time"
 
 	utils "github.com/Lenstra/go-utils/http"
@@ -35,6 +33,19 @@ type Config struct {
 
 	Logger     zerolog.Logger
 	HttpClient *http.Client
+
+	// Retry is the policy used to retry the requests failing with a
+	// transient error, DefaultRetryPolicy is used when it is nil.
+	Retry *RetryPolicy
+
+	// RateLimit limits the rate and concurrency of the requests sent to
+	// Logto, no limit applies when it is nil.
+	RateLimit *RateLimit
+
+	// Authenticator obtains the access tokens used to call the Management
+	// API. When nil, the client credentials grant is used with
+	// ApplicationID and ApplicationSecret.
+	Authenticator Authenticator
 }
 
 func DefaultConfig() *Config {
@@ -47,15 +58,14 @@ func DefaultConfig() *Config {
 		HttpClient: &http.Client{
 			Timeout: 60 * time.Second,
 		},
+		Retry: DefaultRetryPolicy(),
 	}
 }
 
 type Client struct {
-	conf *Config
-
-	accessTokenLock    sync.Mutex
-	accessToken        string
-	accessTokenExpires time.Time
+	conf    *Config
+	limiter *limiter
+	tokens  *tokenSource
 }
 
 func NewClient(config *Config) (*Client, error) {
@@ -80,14 +90,27 @@ func NewClient(config *Config) (*Client, error) {
 	if config.HttpClient == nil {
 		config.HttpClient = defConfig.HttpClient
 	}
+	if config.Retry == nil {
+		config.Retry = defConfig.Retry
+	}
+
+	if config.Authenticator == nil {
+		config.Authenticator = &ClientSecret{
+			ApplicationID:     config.ApplicationID,
+			ApplicationSecret: config.ApplicationSecret,
+		}
+	}
 
 	if config.Hostname == "" {
 		return nil, fmt.Errorf("missing Logto hostname")
 	}
 
-	return &Client{
-		conf: config,
-	}, nil
+	c := &Client{
+		conf:    config,
+		limiter: newLimiter(config.RateLimit),
+	}
+	c.tokens = &tokenSource{fetch: c.getAccessToken}
+	return c, nil
 }
 
 type request struct {
@@ -98,6 +121,10 @@ type request struct {
 	headers                            map[string]string
 	queryParameters                    map[string]string
 	application_id, application_secret string
+
+	// unauthenticated requests are sent without an access token, using
+	// basic authentication when application_id is set.
+	unauthenticated bool
 }
 
 func (r *request) toHttpRequest(ctx context.Context, conf *Config) (*http.Request, error) {
@@ -135,90 +162,95 @@ func (r *request) toHttpRequest(ctx context.Context, conf *Config) (*http.Reques
 	return req, nil
 }
 
-func (c *Client) getAccessToken(ctx context.Context) (string, error) {
-	c.accessTokenLock.Lock()
-	defer c.accessTokenLock.Unlock()
-	if c.accessToken != "" && c.accessTokenExpires.Before(time.Now()) {
-		return c.accessToken, nil
-	}
-
-	data := url.Values{}
-	data.Set("grant_type", "client_credentials")
-	data.Set("resource", c.conf.Resource)
-	data.Set("scope", "all")
-	data.Encode()
-
-	req := &request{
-		method:             "POST",
-		path:               "oidc/token",
-		application_id:     c.conf.ApplicationID,
-		application_secret: c.conf.ApplicationSecret,
-		rawBody:            strings.NewReader(data.Encode()),
-		headers: map[string]string{
-			"Content-Type": "application/x-www-form-urlencoded",
-		},
-	}
+// do sends the request, retrying it according to the retry policy of the
+// client.
+func (c *Client) do(ctx context.Context, r *request) (*http.Response, error) {
+	for attempt := 1; ; attempt++ {
+		res, err := c.send(ctx, r)
+		if !c.conf.Retry.shouldRetry(r, attempt, res, err) {
+			return res, err
+		}
 
-	resp, err := expect(200)(c.do(ctx, req))
-	if err != nil {
-		return "", err
-	}
-	defer resp.Body.Close()
+		delay := c.conf.Retry.delay(attempt, res)
+		event := c.conf.Logger.Debug().
+			Str("method", r.method).
+			Str("path", r.path).
+			Int("attempt", attempt).
+			Dur("delay", delay)
+		if err != nil {
+			event = event.Err(err)
+		} else {
+			event = event.Int("status_code", res.StatusCode)
+			_, _ = io.Copy(io.Discard, res.Body)
+			res.Body.Close()
+		}
+		event.Msg("retrying request")
 
-	type Response struct {
-		AccessToken string `json:"access_token"`
-		ExpiresIn   int    `json:"expires_in"`
-		TokenType   string `json:"token_type"`
-		Scope       string `json:"scope"`
+		if err := sleep(ctx, delay); err != nil {
+			return nil, err
+		}
 	}
+}
 
-	var decodedResponse Response
-	dec := json.NewDecoder(resp.Body)
-	if err := dec.Decode(&decodedResponse); err != nil {
-		return "", fmt.Errorf("failed to decode response: %w", err)
+// send sends a single attempt of the request. When Logto rejects the access
+// token, a new one is fetched and the request is sent again once.
+func (c *Client) send(ctx context.Context, r *request) (*http.Response, error) {
+	res, accessToken, err := c.sendOnce(ctx, r, "")
+	if err != nil || res.StatusCode != http.StatusUnauthorized || accessToken == "" || r.rawBody != nil {
+		return res, err
 	}
 
-	if decodedResponse.TokenType != tokenType {
-		return "", fmt.Errorf("unexpected token type %q, expected %q", decodedResponse.TokenType, tokenType)
-	}
+	c.conf.Logger.Debug().
+		Str("method", r.method).
+		Str("path", r.path).
+		Msg("access token rejected, authenticating again")
+	_, _ = io.Copy(io.Discard, res.Body)
+	res.Body.Close()
 
-	c.accessToken = decodedResponse.AccessToken
-	c.accessTokenExpires = time.Now().Add(time.Duration(float64(decodedResponse.ExpiresIn) * 0.7))
-	return c.accessToken, nil
+	res, _, err = c.sendOnce(ctx, r, accessToken)
+	return res, err
 }
 
-func (c *Client) do(ctx context.Context, r *request) (*http.Response, error) {
+func (c *Client) sendOnce(ctx context.Context, r *request, rejected string) (*http.Response, string, error) {
 	req, err := r.toHttpRequest(ctx, c.conf)
 	if err != nil {
-		return nil, err
+		return nil, "", err
 	}
 
-	if r.application_id != "" {
-		req.SetBasicAuth(r.application_id, r.application_secret)
-	} else {
-		accessToken, err := c.getAccessToken(ctx)
-		if err != nil {
-			return nil, err
-		}
-		req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", accessToken))
+	accessToken, err := c.authorize(ctx, r, req, rejected)
+	if err != nil {
+		return nil, "", err
 	}
 
 	err = utils.LogRequest(c.conf.Logger.Trace(), req, nil)
 	if err != nil {
-		return nil, err
+		return nil, "", err
+	}
+
+	release, waited, err := c.limiter.acquire(ctx)
+	if waited > 0 {
+		c.conf.Logger.Trace().
+			Str("method", r.method).
+			Str("path", r.path).
+			Dur("wait", waited).
+			Msg("waited for rate limiter")
+	}
+	if err != nil {
+		return nil, "", err
 	}
 
 	resp, err := c.conf.HttpClient.Do(req)
+	release()
 	if err != nil {
-		return nil, err
+		return nil, "", err
 	}
 
 	err = utils.LogResponse(c.conf.Logger.Trace(), resp, nil)
 	if err != nil {
-		return nil, err
+		return nil, "", err
 	}
 
-	return resp, nil
+	return resp, accessToken, nil
 }
 
 func decode(r io.ReadCloser, out any) error {
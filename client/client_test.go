@@ -0,0 +1,31 @@
+package client
+
+import (
+	"os"
+	"testing"
+
+	"github.com/Lenstra/terraform-provider-logto/client/logtotest"
+	"github.com/rs/zerolog"
+)
+
+// newTestConfig returns the configuration used by the tests. When no tenant
+// is configured through the LOGTO_HOSTNAME environment variable, an
+// in-process fake of the Logto Management API is started instead.
+func newTestConfig(t *testing.T) *Config {
+	t.Helper()
+
+	config := DefaultConfig()
+	config.Logger = zerolog.New(os.Stdout)
+	if config.Hostname != "" {
+		return config
+	}
+
+	server := logtotest.NewServer()
+	t.Cleanup(server.Close)
+
+	config.Hostname = server.Hostname()
+	config.ApplicationID = server.ApplicationID
+	config.ApplicationSecret = server.ApplicationSecret
+	config.HttpClient = server.Client()
+	return config
+}
@@ -0,0 +1,107 @@
+package logtotest
+
+import (
+	"net/http"
+	"slices"
+)
+
+func (s *Server) registerApplicationRoles(mux *http.ServeMux) {
+	mux.HandleFunc("GET /api/applications/{id}/roles", s.listApplicationRoles)
+	mux.HandleFunc("POST /api/applications/{id}/roles", s.assignApplicationRoles)
+	mux.HandleFunc("PUT /api/applications/{id}/roles", s.replaceApplicationRoles)
+	mux.HandleFunc("DELETE /api/applications/{id}/roles/{roleId}", s.deleteApplicationRole)
+}
+
+func (s *Server) listApplicationRoles(w http.ResponseWriter, r *http.Request) {
+	id := r.PathValue("id")
+	if _, found := s.applications.get(id); !found {
+		writeNotFound(w, id)
+		return
+	}
+
+	roles := []object{}
+	for _, roleID := range s.applicationRoles[id] {
+		if role, found := s.roles.get(roleID); found {
+			roles = append(roles, role)
+		}
+	}
+	writeJSON(w, http.StatusOK, paginate(w, r, roles))
+}
+
+func (s *Server) assignApplicationRoles(w http.ResponseWriter, r *http.Request) {
+	id := r.PathValue("id")
+	roleIDs, ok := s.readApplicationRoles(w, r, id)
+	if !ok {
+		return
+	}
+
+	for _, roleID := range roleIDs {
+		if !slices.Contains(s.applicationRoles[id], roleID) {
+			s.applicationRoles[id] = append(s.applicationRoles[id], roleID)
+		}
+	}
+	w.WriteHeader(http.StatusCreated)
+}
+
+func (s *Server) replaceApplicationRoles(w http.ResponseWriter, r *http.Request) {
+	id := r.PathValue("id")
+	roleIDs, ok := s.readApplicationRoles(w, r, id)
+	if !ok {
+		return
+	}
+
+	s.applicationRoles[id] = roleIDs
+	w.WriteHeader(http.StatusOK)
+}
+
+func (s *Server) deleteApplicationRole(w http.ResponseWriter, r *http.Request) {
+	id, roleID := r.PathValue("id"), r.PathValue("roleId")
+	if _, found := s.applications.get(id); !found {
+		writeNotFound(w, id)
+		return
+	}
+	if !slices.Contains(s.applicationRoles[id], roleID) {
+		writeNotFound(w, roleID)
+		return
+	}
+
+	s.applicationRoles[id] = slices.DeleteFunc(s.applicationRoles[id], func(r string) bool { return r == roleID })
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// readApplicationRoles decodes the roles to assign to an application. Like
+// Logto, only machine-to-machine applications can be given roles and the
+// roles must be machine-to-machine roles.
+func (s *Server) readApplicationRoles(w http.ResponseWriter, r *http.Request, id string) ([]string, bool) {
+	app, found := s.applications.get(id)
+	if !found {
+		writeNotFound(w, id)
+		return nil, false
+	}
+	if app["type"] != "MachineToMachine" {
+		writeError(w, http.StatusUnprocessableEntity, "application.invalid_type", "Only machine to machine applications can have associated roles.")
+		return nil, false
+	}
+	body, ok := readBody(w, r)
+	if !ok {
+		return nil, false
+	}
+
+	roleIDs, ok := stringSlice(body["roleIds"])
+	if !ok {
+		writeError(w, http.StatusBadRequest, "guard.invalid_input", "roleIds should be an array of strings")
+		return nil, false
+	}
+	for _, roleID := range roleIDs {
+		role, found := s.roles.get(roleID)
+		if !found {
+			writeNotFound(w, roleID)
+			return nil, false
+		}
+		if role["type"] != "MachineToMachine" {
+			writeError(w, http.StatusUnprocessableEntity, "role.type_mismatch", "The role type should be MachineToMachine.")
+			return nil, false
+		}
+	}
+	return roleIDs, true
+}
@@ -0,0 +1,281 @@
+package logtotest
+
+import (
+	"fmt"
+	"net/http"
+	"slices"
+)
+
+func (s *Server) registerApplications(mux *http.ServeMux) {
+	mux.HandleFunc("GET /api/applications", s.listApplications)
+	mux.HandleFunc("POST /api/applications", s.createApplication)
+	mux.HandleFunc("GET /api/applications/{id}", s.getApplication)
+	mux.HandleFunc("PATCH /api/applications/{id}", s.updateApplication)
+	mux.HandleFunc("DELETE /api/applications/{id}", s.deleteApplication)
+	mux.HandleFunc("GET /api/applications/{id}/secrets", s.listApplicationSecrets)
+	mux.HandleFunc("POST /api/applications/{id}/secrets", s.createApplicationSecret)
+	mux.HandleFunc("PATCH /api/applications/{id}/secrets/{name}", s.updateApplicationSecret)
+	mux.HandleFunc("DELETE /api/applications/{id}/secrets/{name}", s.deleteApplicationSecret)
+	mux.HandleFunc("POST /api/applications/{id}/protected-app-metadata/custom-domains", s.addCustomDomain)
+	mux.HandleFunc("DELETE /api/applications/{id}/protected-app-metadata/custom-domains/{domain}", s.removeCustomDomain)
+}
+
+func (s *Server) listApplications(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	match := search(r, "id", "name", "description")
+	apps := s.applications.list(func(app object) bool {
+		if match != nil && !match(app) {
+			return false
+		}
+		if types := query["types"]; len(types) != 0 && !slices.Contains(types, app["type"].(string)) {
+			return false
+		}
+		if query.Has("isThirdParty") && app["isThirdParty"] != (query.Get("isThirdParty") == "true") {
+			return false
+		}
+		return true
+	})
+	writeJSON(w, http.StatusOK, paginate(w, r, apps))
+}
+
+func (s *Server) createApplication(w http.ResponseWriter, r *http.Request) {
+	body, ok := readBody(w, r)
+	if !ok {
+		return
+	}
+
+	app := object{
+		"tenantId":    TenantID,
+		"id":          newID(),
+		"description": nil,
+		"oidcClientMetadata": object{
+			"redirectUris":           []any{},
+			"postLogoutRedirectUris": []any{},
+		},
+		"customClientMetadata": object{},
+		"customData":           object{},
+		"protectedAppMetadata": nil,
+		"isAdmin":              false,
+		"isThirdParty":         false,
+		"createdAt":            now(),
+	}
+	merge(app, pick(body, "name", "description", "type", "oidcClientMetadata", "customClientMetadata", "customData", "isThirdParty"))
+
+	if app["type"] == "Protected" {
+		metadata, _ := body["protectedAppMetadata"].(object)
+		subDomain, _ := metadata["subDomain"].(string)
+		origin, _ := metadata["origin"].(string)
+		if subDomain == "" || origin == "" {
+			writeError(w, http.StatusBadRequest, "application.protected_app_metadata_is_required", "Protected app metadata is required.")
+			return
+		}
+
+		host := subDomain + ".protected.app"
+		app["protectedAppMetadata"] = object{
+			"host":            host,
+			"origin":          origin,
+			"sessionDuration": float64(60 * 60 * 24 * 14),
+			"pageRules":       []any{},
+			"customDomains":   []any{},
+		}
+		app["oidcClientMetadata"] = object{
+			"redirectUris":           []any{"https://" + host + "/callback"},
+			"postLogoutRedirectUris": []any{"https://" + host},
+		}
+	}
+
+	s.applications.put(app)
+
+	switch app["type"] {
+	case "Native", "SPA":
+	default:
+		s.secrets[app["id"].(string)] = []object{newSecret(app["id"].(string), "Default secret", nil)}
+	}
+
+	writeJSON(w, http.StatusOK, app)
+}
+
+func (s *Server) getApplication(w http.ResponseWriter, r *http.Request) {
+	app, found := s.applications.get(r.PathValue("id"))
+	if !found {
+		writeNotFound(w, r.PathValue("id"))
+		return
+	}
+	writeJSON(w, http.StatusOK, app)
+}
+
+func (s *Server) updateApplication(w http.ResponseWriter, r *http.Request) {
+	app, found := s.applications.get(r.PathValue("id"))
+	if !found {
+		writeNotFound(w, r.PathValue("id"))
+		return
+	}
+	body, ok := readBody(w, r)
+	if !ok {
+		return
+	}
+
+	// Logto replaces the client metadata and the custom data instead of
+	// merging them.
+	for _, key := range []string{"oidcClientMetadata", "customClientMetadata", "customData"} {
+		if value, found := body[key]; found {
+			app[key] = value
+		}
+	}
+	merge(app, pick(body, "name", "description", "protectedAppMetadata", "isAdmin"))
+	writeJSON(w, http.StatusOK, app)
+}
+
+func (s *Server) deleteApplication(w http.ResponseWriter, r *http.Request) {
+	id := r.PathValue("id")
+	if !s.applications.delete(id) {
+		writeNotFound(w, id)
+		return
+	}
+	delete(s.secrets, id)
+	delete(s.applicationRoles, id)
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *Server) listApplicationSecrets(w http.ResponseWriter, r *http.Request) {
+	id := r.PathValue("id")
+	if _, found := s.applications.get(id); !found {
+		writeNotFound(w, id)
+		return
+	}
+	secrets := s.secrets[id]
+	if secrets == nil {
+		secrets = []object{}
+	}
+	writeJSON(w, http.StatusOK, secrets)
+}
+
+func (s *Server) createApplicationSecret(w http.ResponseWriter, r *http.Request) {
+	id := r.PathValue("id")
+	if _, found := s.applications.get(id); !found {
+		writeNotFound(w, id)
+		return
+	}
+	body, ok := readBody(w, r)
+	if !ok {
+		return
+	}
+	name, _ := body["name"].(string)
+	if name == "" {
+		writeError(w, http.StatusBadRequest, "guard.invalid_input", "name is required")
+		return
+	}
+	if _, found := s.findSecret(id, name); found {
+		writeError(w, http.StatusUnprocessableEntity, "application.secret_name_exists", fmt.Sprintf("Secret with the same name %s already exists.", name))
+		return
+	}
+
+	secret := newSecret(id, name, body["expiresAt"])
+	s.secrets[id] = append(s.secrets[id], secret)
+	writeJSON(w, http.StatusCreated, secret)
+}
+
+func (s *Server) updateApplicationSecret(w http.ResponseWriter, r *http.Request) {
+	id, name := r.PathValue("id"), r.PathValue("name")
+	secret, found := s.findSecret(id, name)
+	if !found {
+		writeNotFound(w, name)
+		return
+	}
+	body, ok := readBody(w, r)
+	if !ok {
+		return
+	}
+	if newName, _ := body["name"].(string); newName != "" && newName != name {
+		if _, found := s.findSecret(id, newName); found {
+			writeError(w, http.StatusUnprocessableEntity, "application.secret_name_exists", fmt.Sprintf("Secret with the same name %s already exists.", newName))
+			return
+		}
+		secret["name"] = newName
+	}
+	writeJSON(w, http.StatusOK, secret)
+}
+
+func (s *Server) deleteApplicationSecret(w http.ResponseWriter, r *http.Request) {
+	id, name := r.PathValue("id"), r.PathValue("name")
+	if _, found := s.findSecret(id, name); !found {
+		writeNotFound(w, name)
+		return
+	}
+	s.secrets[id] = slices.DeleteFunc(s.secrets[id], func(o object) bool { return o["name"] == name })
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *Server) addCustomDomain(w http.ResponseWriter, r *http.Request) {
+	app, found := s.applications.get(r.PathValue("id"))
+	if !found {
+		writeNotFound(w, r.PathValue("id"))
+		return
+	}
+	metadata, ok := app["protectedAppMetadata"].(object)
+	if !ok {
+		writeError(w, http.StatusBadRequest, "application.protected_application_only", "This feature is only available for protected applications.")
+		return
+	}
+	body, ok := readBody(w, r)
+	if !ok {
+		return
+	}
+	domain, _ := body["domain"].(string)
+	if domain == "" {
+		writeError(w, http.StatusBadRequest, "guard.invalid_input", "domain is required")
+		return
+	}
+
+	domains, _ := metadata["customDomains"].([]any)
+	for _, existing := range domains {
+		if existing.(object)["domain"] == domain {
+			writeError(w, http.StatusUnprocessableEntity, "domain.hostname_already_exists", fmt.Sprintf("The domain %s already exists.", domain))
+			return
+		}
+	}
+	metadata["customDomains"] = append(domains, object{
+		"domain":       domain,
+		"status":       "PendingVerification",
+		"errorMessage": nil,
+		"dnsRecords":   []any{},
+	})
+	w.WriteHeader(http.StatusCreated)
+}
+
+func (s *Server) removeCustomDomain(w http.ResponseWriter, r *http.Request) {
+	app, found := s.applications.get(r.PathValue("id"))
+	if !found {
+		writeNotFound(w, r.PathValue("id"))
+		return
+	}
+	metadata, _ := app["protectedAppMetadata"].(object)
+	domains, _ := metadata["customDomains"].([]any)
+	remaining := slices.DeleteFunc(slices.Clone(domains), func(o any) bool { return o.(object)["domain"] == r.PathValue("domain") })
+	if len(remaining) == len(domains) {
+		writeNotFound(w, r.PathValue("domain"))
+		return
+	}
+	metadata["customDomains"] = remaining
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *Server) findSecret(applicationID, name string) (object, bool) {
+	for _, secret := range s.secrets[applicationID] {
+		if secret["name"] == name {
+			return secret, true
+		}
+	}
+	return nil, false
+}
+
+func newSecret(applicationID, name string, expiresAt any) object {
+	return object{
+		"tenantId":      TenantID,
+		"applicationId": applicationID,
+		"name":          name,
+		"value":         newID() + newID(),
+		"createdAt":     now(),
+		"expiresAt":     expiresAt,
+	}
+}
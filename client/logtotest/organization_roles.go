@@ -0,0 +1,184 @@
+package logtotest
+
+import (
+	"net/http"
+	"slices"
+)
+
+func (s *Server) registerOrganizationRoles(mux *http.ServeMux) {
+	mux.HandleFunc("GET /api/organization-roles", s.listOrganizationRoles)
+	mux.HandleFunc("POST /api/organization-roles", s.createOrganizationRole)
+	mux.HandleFunc("GET /api/organization-roles/{id}", s.getOrganizationRole)
+	mux.HandleFunc("PATCH /api/organization-roles/{id}", s.updateOrganizationRole)
+	mux.HandleFunc("DELETE /api/organization-roles/{id}", s.deleteOrganizationRole)
+	mux.HandleFunc("GET /api/organization-roles/{id}/scopes", s.listOrganizationRoleScopes)
+	mux.HandleFunc("PUT /api/organization-roles/{id}/scopes", s.replaceOrganizationRoleScopes)
+	mux.HandleFunc("GET /api/organization-roles/{id}/resource-scopes", s.listOrganizationRoleResourceScopes)
+	mux.HandleFunc("PUT /api/organization-roles/{id}/resource-scopes", s.replaceOrganizationRoleResourceScopes)
+}
+
+func (s *Server) listOrganizationRoles(w http.ResponseWriter, r *http.Request) {
+	writeJSON(w, http.StatusOK, paginate(w, r, s.organizationRoles.list(nil)))
+}
+
+func (s *Server) createOrganizationRole(w http.ResponseWriter, r *http.Request) {
+	body, ok := readBody(w, r)
+	if !ok {
+		return
+	}
+	if !checkNameUniqueness(w, s.organizationRoles, "", body) {
+		return
+	}
+	scopeIDs, ok := readIDs(w, body, "organizationScopeIds", s.organizationScopes)
+	if !ok {
+		return
+	}
+	resourceScopeIDs, ok := readIDs(w, body, "resourceScopeIds", s.scopes)
+	if !ok {
+		return
+	}
+
+	role := object{
+		"tenantId":    TenantID,
+		"id":          newID(),
+		"description": nil,
+		"type":        "User",
+	}
+	merge(role, pick(body, "name", "description", "type"))
+	s.organizationRoles.put(role)
+	s.organizationRoleScopes[role["id"].(string)] = scopeIDs
+	s.organizationRoleResourceScopes[role["id"].(string)] = resourceScopeIDs
+
+	writeJSON(w, http.StatusCreated, role)
+}
+
+func (s *Server) getOrganizationRole(w http.ResponseWriter, r *http.Request) {
+	role, found := s.organizationRoles.get(r.PathValue("id"))
+	if !found {
+		writeNotFound(w, r.PathValue("id"))
+		return
+	}
+	writeJSON(w, http.StatusOK, role)
+}
+
+func (s *Server) updateOrganizationRole(w http.ResponseWriter, r *http.Request) {
+	id := r.PathValue("id")
+	role, found := s.organizationRoles.get(id)
+	if !found {
+		writeNotFound(w, id)
+		return
+	}
+	body, ok := readBody(w, r)
+	if !ok {
+		return
+	}
+	if !checkNameUniqueness(w, s.organizationRoles, id, body) {
+		return
+	}
+
+	merge(role, pick(body, "name", "description", "type"))
+	writeJSON(w, http.StatusOK, role)
+}
+
+func (s *Server) deleteOrganizationRole(w http.ResponseWriter, r *http.Request) {
+	id := r.PathValue("id")
+	if !s.organizationRoles.delete(id) {
+		writeNotFound(w, id)
+		return
+	}
+	delete(s.organizationRoleScopes, id)
+	delete(s.organizationRoleResourceScopes, id)
+	s.removeOrganizationRoleFromMembers(id)
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *Server) listOrganizationRoleScopes(w http.ResponseWriter, r *http.Request) {
+	id := r.PathValue("id")
+	if _, found := s.organizationRoles.get(id); !found {
+		writeNotFound(w, id)
+		return
+	}
+
+	scopes := []object{}
+	for _, scopeID := range s.organizationRoleScopes[id] {
+		if scope, found := s.organizationScopes.get(scopeID); found {
+			scopes = append(scopes, scope)
+		}
+	}
+	writeJSON(w, http.StatusOK, paginate(w, r, scopes))
+}
+
+func (s *Server) replaceOrganizationRoleScopes(w http.ResponseWriter, r *http.Request) {
+	id := r.PathValue("id")
+	if _, found := s.organizationRoles.get(id); !found {
+		writeNotFound(w, id)
+		return
+	}
+	body, ok := readBody(w, r)
+	if !ok {
+		return
+	}
+	scopeIDs, ok := readIDs(w, body, "organizationScopeIds", s.organizationScopes)
+	if !ok {
+		return
+	}
+
+	s.organizationRoleScopes[id] = scopeIDs
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *Server) listOrganizationRoleResourceScopes(w http.ResponseWriter, r *http.Request) {
+	id := r.PathValue("id")
+	if _, found := s.organizationRoles.get(id); !found {
+		writeNotFound(w, id)
+		return
+	}
+
+	scopes := []object{}
+	for _, scopeID := range s.organizationRoleResourceScopes[id] {
+		if scope, found := s.scopes.get(scopeID); found {
+			scopes = append(scopes, s.withResource(scope))
+		}
+	}
+	writeJSON(w, http.StatusOK, paginate(w, r, scopes))
+}
+
+func (s *Server) replaceOrganizationRoleResourceScopes(w http.ResponseWriter, r *http.Request) {
+	id := r.PathValue("id")
+	if _, found := s.organizationRoles.get(id); !found {
+		writeNotFound(w, id)
+		return
+	}
+	body, ok := readBody(w, r)
+	if !ok {
+		return
+	}
+	scopeIDs, ok := readIDs(w, body, "scopeIds", s.scopes)
+	if !ok {
+		return
+	}
+
+	s.organizationRoleResourceScopes[id] = scopeIDs
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// readIDs decodes the list of IDs stored under key in the body, making sure
+// each of them references an object of the collection.
+func readIDs(w http.ResponseWriter, body object, key string, c *collection) ([]string, bool) {
+	value, found := body[key]
+	if !found {
+		return nil, true
+	}
+	ids, ok := stringSlice(value)
+	if !ok {
+		writeError(w, http.StatusBadRequest, "guard.invalid_input", key+" should be an array of strings")
+		return nil, false
+	}
+	for _, id := range ids {
+		if _, found := c.get(id); !found {
+			writeNotFound(w, id)
+			return nil, false
+		}
+	}
+	return slices.Compact(slices.Sorted(slices.Values(ids))), true
+}
@@ -0,0 +1,95 @@
+package logtotest
+
+import (
+	"net/http"
+	"slices"
+)
+
+func (s *Server) registerOrganizationScopes(mux *http.ServeMux) {
+	mux.HandleFunc("GET /api/organization-scopes", s.listOrganizationScopes)
+	mux.HandleFunc("POST /api/organization-scopes", s.createOrganizationScope)
+	mux.HandleFunc("GET /api/organization-scopes/{id}", s.getOrganizationScope)
+	mux.HandleFunc("PATCH /api/organization-scopes/{id}", s.updateOrganizationScope)
+	mux.HandleFunc("DELETE /api/organization-scopes/{id}", s.deleteOrganizationScope)
+}
+
+func (s *Server) listOrganizationScopes(w http.ResponseWriter, r *http.Request) {
+	writeJSON(w, http.StatusOK, paginate(w, r, s.organizationScopes.list(nil)))
+}
+
+func (s *Server) createOrganizationScope(w http.ResponseWriter, r *http.Request) {
+	body, ok := readBody(w, r)
+	if !ok {
+		return
+	}
+	if !checkNameUniqueness(w, s.organizationScopes, "", body) {
+		return
+	}
+
+	scope := object{
+		"tenantId":    TenantID,
+		"id":          newID(),
+		"description": nil,
+	}
+	merge(scope, pick(body, "name", "description"))
+	s.organizationScopes.put(scope)
+
+	writeJSON(w, http.StatusCreated, scope)
+}
+
+func (s *Server) getOrganizationScope(w http.ResponseWriter, r *http.Request) {
+	scope, found := s.organizationScopes.get(r.PathValue("id"))
+	if !found {
+		writeNotFound(w, r.PathValue("id"))
+		return
+	}
+	writeJSON(w, http.StatusOK, scope)
+}
+
+func (s *Server) updateOrganizationScope(w http.ResponseWriter, r *http.Request) {
+	id := r.PathValue("id")
+	scope, found := s.organizationScopes.get(id)
+	if !found {
+		writeNotFound(w, id)
+		return
+	}
+	body, ok := readBody(w, r)
+	if !ok {
+		return
+	}
+	if !checkNameUniqueness(w, s.organizationScopes, id, body) {
+		return
+	}
+
+	merge(scope, pick(body, "name", "description"))
+	writeJSON(w, http.StatusOK, scope)
+}
+
+func (s *Server) deleteOrganizationScope(w http.ResponseWriter, r *http.Request) {
+	id := r.PathValue("id")
+	if !s.organizationScopes.delete(id) {
+		writeNotFound(w, id)
+		return
+	}
+	for roleID, scopeIDs := range s.organizationRoleScopes {
+		s.organizationRoleScopes[roleID] = slices.DeleteFunc(scopeIDs, func(s string) bool { return s == id })
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// checkNameUniqueness rejects the bodies reusing the name of another object
+// of the collection, like the organization template endpoints do.
+func checkNameUniqueness(w http.ResponseWriter, c *collection, id string, body object) bool {
+	name, found := body["name"]
+	if !found {
+		return true
+	}
+	_, conflict := c.find(func(o object) bool {
+		return o["id"] != id && o["name"] == name
+	})
+	if conflict {
+		writeError(w, http.StatusUnprocessableEntity, "entity.unique_integrity_violation", "The entity already exists or is in use.")
+		return false
+	}
+	return true
+}
@@ -0,0 +1,153 @@
+package logtotest
+
+import (
+	"maps"
+	"net/http"
+	"slices"
+)
+
+func (s *Server) registerOrganizationUsers(mux *http.ServeMux) {
+	mux.HandleFunc("GET /api/organizations/{id}/users", s.listOrganizationUsers)
+	mux.HandleFunc("POST /api/organizations/{id}/users", s.addOrganizationUsers)
+	mux.HandleFunc("DELETE /api/organizations/{id}/users/{userId}", s.removeOrganizationUser)
+	mux.HandleFunc("GET /api/organizations/{id}/users/{userId}/roles", s.listOrganizationUserRoles)
+	mux.HandleFunc("PUT /api/organizations/{id}/users/{userId}/roles", s.replaceOrganizationUserRoles)
+	mux.HandleFunc("GET /api/users/{id}/organizations", s.listUserOrganizations)
+}
+
+func (s *Server) listOrganizationUsers(w http.ResponseWriter, r *http.Request) {
+	id := r.PathValue("id")
+	if _, found := s.organizations.get(id); !found {
+		writeNotFound(w, id)
+		return
+	}
+
+	users := []object{}
+	for _, user := range s.users.list(nil) {
+		roleIDs, member := s.organizationMembers[id][user["id"].(string)]
+		if !member {
+			continue
+		}
+		user = maps.Clone(user)
+		user["organizationRoles"] = s.organizationRoleRefs(roleIDs)
+		users = append(users, user)
+	}
+	writeJSON(w, http.StatusOK, paginate(w, r, users))
+}
+
+func (s *Server) addOrganizationUsers(w http.ResponseWriter, r *http.Request) {
+	id := r.PathValue("id")
+	if _, found := s.organizations.get(id); !found {
+		writeNotFound(w, id)
+		return
+	}
+	body, ok := readBody(w, r)
+	if !ok {
+		return
+	}
+	userIDs, ok := readIDs(w, body, "userIds", s.users)
+	if !ok {
+		return
+	}
+
+	if s.organizationMembers[id] == nil {
+		s.organizationMembers[id] = map[string][]string{}
+	}
+	for _, userID := range userIDs {
+		if _, member := s.organizationMembers[id][userID]; !member {
+			s.organizationMembers[id][userID] = []string{}
+		}
+	}
+	w.WriteHeader(http.StatusCreated)
+}
+
+func (s *Server) removeOrganizationUser(w http.ResponseWriter, r *http.Request) {
+	id, userID := r.PathValue("id"), r.PathValue("userId")
+	if _, member := s.organizationMembers[id][userID]; !member {
+		writeNotFound(w, userID)
+		return
+	}
+	delete(s.organizationMembers[id], userID)
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *Server) listOrganizationUserRoles(w http.ResponseWriter, r *http.Request) {
+	id, userID := r.PathValue("id"), r.PathValue("userId")
+	roleIDs, member := s.organizationMembers[id][userID]
+	if !member {
+		writeNotFound(w, userID)
+		return
+	}
+
+	roles := []object{}
+	for _, roleID := range roleIDs {
+		if role, found := s.organizationRoles.get(roleID); found {
+			roles = append(roles, role)
+		}
+	}
+	writeJSON(w, http.StatusOK, paginate(w, r, roles))
+}
+
+func (s *Server) replaceOrganizationUserRoles(w http.ResponseWriter, r *http.Request) {
+	id, userID := r.PathValue("id"), r.PathValue("userId")
+	if _, member := s.organizationMembers[id][userID]; !member {
+		writeNotFound(w, userID)
+		return
+	}
+	body, ok := readBody(w, r)
+	if !ok {
+		return
+	}
+	roleIDs, ok := readIDs(w, body, "organizationRoleIds", s.organizationRoles)
+	if !ok {
+		return
+	}
+	if roleIDs == nil {
+		roleIDs = []string{}
+	}
+
+	s.organizationMembers[id][userID] = roleIDs
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *Server) listUserOrganizations(w http.ResponseWriter, r *http.Request) {
+	id := r.PathValue("id")
+	if _, found := s.users.get(id); !found {
+		writeNotFound(w, id)
+		return
+	}
+
+	organizations := []object{}
+	for _, organization := range s.organizations.list(nil) {
+		roleIDs, member := s.organizationMembers[organization["id"].(string)][id]
+		if !member {
+			continue
+		}
+		organization = maps.Clone(organization)
+		organization["organizationRoles"] = s.organizationRoleRefs(roleIDs)
+		organizations = append(organizations, organization)
+	}
+	writeJSON(w, http.StatusOK, organizations)
+}
+
+// organizationRoleRefs returns the short form of the organization roles
+// embedded by Logto in the membership listings.
+func (s *Server) organizationRoleRefs(roleIDs []string) []object {
+	roles := []object{}
+	for _, roleID := range roleIDs {
+		if role, found := s.organizationRoles.get(roleID); found {
+			roles = append(roles, pick(role, "id", "name"))
+		}
+	}
+	return roles
+}
+
+// removeOrganizationRoleFromMembers unlinks a deleted organization role from
+// every member of every organization.
+func (s *Server) removeOrganizationRoleFromMembers(roleID string) {
+	for _, members := range s.organizationMembers {
+		for userID, roleIDs := range members {
+			members[userID] = slices.DeleteFunc(roleIDs, func(r string) bool { return r == roleID })
+		}
+	}
+}
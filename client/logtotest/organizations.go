@@ -0,0 +1,77 @@
+package logtotest
+
+import (
+	"net/http"
+)
+
+func (s *Server) registerOrganizations(mux *http.ServeMux) {
+	mux.HandleFunc("GET /api/organizations", s.listOrganizations)
+	mux.HandleFunc("POST /api/organizations", s.createOrganization)
+	mux.HandleFunc("GET /api/organizations/{id}", s.getOrganization)
+	mux.HandleFunc("PATCH /api/organizations/{id}", s.updateOrganization)
+	mux.HandleFunc("DELETE /api/organizations/{id}", s.deleteOrganization)
+}
+
+func (s *Server) listOrganizations(w http.ResponseWriter, r *http.Request) {
+	writeJSON(w, http.StatusOK, paginate(w, r, s.organizations.list(nil)))
+}
+
+func (s *Server) createOrganization(w http.ResponseWriter, r *http.Request) {
+	body, ok := readBody(w, r)
+	if !ok {
+		return
+	}
+
+	organization := object{
+		"tenantId":      TenantID,
+		"id":            newID(),
+		"description":   nil,
+		"customData":    object{},
+		"isMfaRequired": false,
+		"branding":      object{},
+		"createdAt":     now(),
+	}
+	merge(organization, pick(body, "name", "description", "customData", "isMfaRequired", "branding"))
+	s.organizations.put(organization)
+
+	writeJSON(w, http.StatusCreated, organization)
+}
+
+func (s *Server) getOrganization(w http.ResponseWriter, r *http.Request) {
+	organization, found := s.organizations.get(r.PathValue("id"))
+	if !found {
+		writeNotFound(w, r.PathValue("id"))
+		return
+	}
+	writeJSON(w, http.StatusOK, organization)
+}
+
+// updateOrganization replaces the custom data and the branding instead of
+// merging them, like Logto does.
+func (s *Server) updateOrganization(w http.ResponseWriter, r *http.Request) {
+	id := r.PathValue("id")
+	organization, found := s.organizations.get(id)
+	if !found {
+		writeNotFound(w, id)
+		return
+	}
+	body, ok := readBody(w, r)
+	if !ok {
+		return
+	}
+
+	for key, value := range pick(body, "name", "description", "customData", "isMfaRequired", "branding") {
+		organization[key] = value
+	}
+	writeJSON(w, http.StatusOK, organization)
+}
+
+func (s *Server) deleteOrganization(w http.ResponseWriter, r *http.Request) {
+	id := r.PathValue("id")
+	if !s.organizations.delete(id) {
+		writeNotFound(w, id)
+		return
+	}
+	delete(s.organizationMembers, id)
+	w.WriteHeader(http.StatusNoContent)
+}
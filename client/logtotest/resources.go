@@ -0,0 +1,215 @@
+package logtotest
+
+import (
+	"fmt"
+	"maps"
+	"net/http"
+	"slices"
+)
+
+func (s *Server) registerResources(mux *http.ServeMux) {
+	mux.HandleFunc("GET /api/resources", s.listResources)
+	mux.HandleFunc("POST /api/resources", s.createResource)
+	mux.HandleFunc("GET /api/resources/{id}", s.getResource)
+	mux.HandleFunc("PATCH /api/resources/{id}", s.updateResource)
+	mux.HandleFunc("DELETE /api/resources/{id}", s.deleteResource)
+	mux.HandleFunc("GET /api/resources/{id}/scopes", s.listScopes)
+	mux.HandleFunc("POST /api/resources/{id}/scopes", s.createScope)
+	mux.HandleFunc("PATCH /api/resources/{id}/scopes/{scopeId}", s.updateScope)
+	mux.HandleFunc("DELETE /api/resources/{id}/scopes/{scopeId}", s.deleteScope)
+}
+
+func (s *Server) listResources(w http.ResponseWriter, r *http.Request) {
+	resources := s.resources.list(nil)
+	if r.URL.Query().Get("includeScopes") == "true" {
+		for i, resource := range resources {
+			resource = maps.Clone(resource)
+			resource["scopes"] = s.resourceScopes(resource["id"].(string))
+			resources[i] = resource
+		}
+	}
+	writeJSON(w, http.StatusOK, paginate(w, r, resources))
+}
+
+func (s *Server) createResource(w http.ResponseWriter, r *http.Request) {
+	body, ok := readBody(w, r)
+	if !ok {
+		return
+	}
+	if !s.checkResourceUniqueness(w, "", body) {
+		return
+	}
+
+	resource := object{
+		"tenantId":       TenantID,
+		"id":             newID(),
+		"accessTokenTtl": float64(3600),
+		"isDefault":      false,
+	}
+	merge(resource, pick(body, "name", "indicator", "accessTokenTtl", "isDefault"))
+	s.resources.put(resource)
+
+	writeJSON(w, http.StatusCreated, resource)
+}
+
+func (s *Server) getResource(w http.ResponseWriter, r *http.Request) {
+	resource, found := s.resources.get(r.PathValue("id"))
+	if !found {
+		writeNotFound(w, r.PathValue("id"))
+		return
+	}
+	writeJSON(w, http.StatusOK, resource)
+}
+
+func (s *Server) updateResource(w http.ResponseWriter, r *http.Request) {
+	id := r.PathValue("id")
+	resource, found := s.resources.get(id)
+	if !found {
+		writeNotFound(w, id)
+		return
+	}
+	body, ok := readBody(w, r)
+	if !ok {
+		return
+	}
+	if !s.checkResourceUniqueness(w, id, body) {
+		return
+	}
+
+	merge(resource, pick(body, "name", "indicator", "accessTokenTtl", "isDefault"))
+	writeJSON(w, http.StatusOK, resource)
+}
+
+func (s *Server) deleteResource(w http.ResponseWriter, r *http.Request) {
+	id := r.PathValue("id")
+	if !s.resources.delete(id) {
+		writeNotFound(w, id)
+		return
+	}
+	for _, scope := range s.scopes.list(func(o object) bool { return o["resourceId"] == id }) {
+		s.removeScope(scope["id"].(string))
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *Server) checkResourceUniqueness(w http.ResponseWriter, id string, body object) bool {
+	indicator, found := body["indicator"]
+	if !found {
+		return true
+	}
+	_, conflict := s.resources.find(func(o object) bool {
+		return o["id"] != id && o["indicator"] == indicator
+	})
+	if conflict {
+		writeError(w, http.StatusUnprocessableEntity, "resource.resource_indicator_in_use", fmt.Sprintf("The resource indicator %s is already in use", indicator))
+		return false
+	}
+	return true
+}
+
+func (s *Server) listScopes(w http.ResponseWriter, r *http.Request) {
+	id := r.PathValue("id")
+	if _, found := s.resources.get(id); !found {
+		writeNotFound(w, id)
+		return
+	}
+	writeJSON(w, http.StatusOK, paginate(w, r, s.resourceScopes(id)))
+}
+
+func (s *Server) createScope(w http.ResponseWriter, r *http.Request) {
+	id := r.PathValue("id")
+	if _, found := s.resources.get(id); !found {
+		writeNotFound(w, id)
+		return
+	}
+	body, ok := readBody(w, r)
+	if !ok {
+		return
+	}
+	if !s.checkScopeUniqueness(w, id, "", body) {
+		return
+	}
+
+	scope := object{
+		"tenantId":    TenantID,
+		"id":          newID(),
+		"resourceId":  id,
+		"description": nil,
+		"createdAt":   now(),
+	}
+	merge(scope, pick(body, "name", "description"))
+	s.scopes.put(scope)
+
+	writeJSON(w, http.StatusCreated, scope)
+}
+
+func (s *Server) updateScope(w http.ResponseWriter, r *http.Request) {
+	id, scopeID := r.PathValue("id"), r.PathValue("scopeId")
+	scope, found := s.scopes.get(scopeID)
+	if !found || scope["resourceId"] != id {
+		writeNotFound(w, scopeID)
+		return
+	}
+	body, ok := readBody(w, r)
+	if !ok {
+		return
+	}
+	if !s.checkScopeUniqueness(w, id, scopeID, body) {
+		return
+	}
+
+	merge(scope, pick(body, "name", "description"))
+	writeJSON(w, http.StatusOK, scope)
+}
+
+func (s *Server) deleteScope(w http.ResponseWriter, r *http.Request) {
+	id, scopeID := r.PathValue("id"), r.PathValue("scopeId")
+	scope, found := s.scopes.get(scopeID)
+	if !found || scope["resourceId"] != id {
+		writeNotFound(w, scopeID)
+		return
+	}
+	s.removeScope(scopeID)
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *Server) checkScopeUniqueness(w http.ResponseWriter, resourceID, id string, body object) bool {
+	name, found := body["name"]
+	if !found {
+		return true
+	}
+	_, conflict := s.scopes.find(func(o object) bool {
+		return o["resourceId"] == resourceID && o["id"] != id && o["name"] == name
+	})
+	if conflict {
+		writeError(w, http.StatusUnprocessableEntity, "scope.name_exists", fmt.Sprintf("The scope name %s is already in use", name))
+		return false
+	}
+	return true
+}
+
+func (s *Server) resourceScopes(resourceID string) []object {
+	return s.scopes.list(func(o object) bool { return o["resourceId"] == resourceID })
+}
+
+// removeScope deletes a scope and unlinks it from every role, including the
+// organization roles.
+func (s *Server) removeScope(id string) {
+	s.scopes.delete(id)
+	for roleID, scopeIDs := range s.roleScopes {
+		s.roleScopes[roleID] = slices.DeleteFunc(scopeIDs, func(s string) bool { return s == id })
+	}
+	for roleID, scopeIDs := range s.organizationRoleResourceScopes {
+		s.organizationRoleResourceScopes[roleID] = slices.DeleteFunc(scopeIDs, func(s string) bool { return s == id })
+	}
+}
+
+// withResource returns a copy of the scope embedding its API resource, as
+// returned by the role scopes endpoints.
+func (s *Server) withResource(scope object) object {
+	scope = maps.Clone(scope)
+	if resource, found := s.resources.get(scope["resourceId"].(string)); found {
+		scope["resource"] = resource
+	}
+	return scope
+}
@@ -0,0 +1,323 @@
+package logtotest
+
+import (
+	"fmt"
+	"net/http"
+	"slices"
+)
+
+func (s *Server) registerRoles(mux *http.ServeMux) {
+	mux.HandleFunc("GET /api/roles", s.listRoles)
+	mux.HandleFunc("POST /api/roles", s.createRole)
+	mux.HandleFunc("GET /api/roles/{id}", s.getRole)
+	mux.HandleFunc("PATCH /api/roles/{id}", s.updateRole)
+	mux.HandleFunc("DELETE /api/roles/{id}", s.deleteRole)
+	mux.HandleFunc("GET /api/roles/{id}/scopes", s.listRoleScopes)
+	mux.HandleFunc("POST /api/roles/{id}/scopes", s.assignRoleScopes)
+	mux.HandleFunc("DELETE /api/roles/{id}/scopes/{scopeId}", s.deleteRoleScope)
+	mux.HandleFunc("GET /api/roles/{id}/users", s.listRoleUsers)
+	mux.HandleFunc("POST /api/roles/{id}/users", s.assignRoleUsers)
+	mux.HandleFunc("DELETE /api/roles/{id}/users/{userId}", s.deleteRoleUser)
+	mux.HandleFunc("GET /api/roles/{id}/applications", s.listRoleApplications)
+	mux.HandleFunc("POST /api/roles/{id}/applications", s.assignRoleApplications)
+	mux.HandleFunc("DELETE /api/roles/{id}/applications/{applicationId}", s.deleteRoleApplication)
+}
+
+func (s *Server) listRoles(w http.ResponseWriter, r *http.Request) {
+	match := search(r, "id", "name", "description")
+	roleType := r.URL.Query().Get("type")
+	roles := s.roles.list(func(role object) bool {
+		return (match == nil || match(role)) && (roleType == "" || role["type"] == roleType)
+	})
+	writeJSON(w, http.StatusOK, paginate(w, r, roles))
+}
+
+func (s *Server) createRole(w http.ResponseWriter, r *http.Request) {
+	body, ok := readBody(w, r)
+	if !ok {
+		return
+	}
+	if !s.checkRoleUniqueness(w, "", body) {
+		return
+	}
+
+	var scopeIDs []string
+	if value, found := body["scopeIds"]; found {
+		scopeIDs, ok = stringSlice(value)
+		if !ok {
+			writeError(w, http.StatusBadRequest, "guard.invalid_input", "scopeIds should be an array of strings")
+			return
+		}
+	}
+	for _, scopeID := range scopeIDs {
+		if _, found := s.scopes.get(scopeID); !found {
+			writeNotFound(w, scopeID)
+			return
+		}
+	}
+
+	role := object{
+		"tenantId":    TenantID,
+		"id":          newID(),
+		"description": "",
+		"type":        "User",
+		"isDefault":   false,
+	}
+	merge(role, pick(body, "name", "description", "type", "isDefault"))
+	s.roles.put(role)
+	s.roleScopes[role["id"].(string)] = scopeIDs
+
+	writeJSON(w, http.StatusOK, role)
+}
+
+func (s *Server) getRole(w http.ResponseWriter, r *http.Request) {
+	role, found := s.roles.get(r.PathValue("id"))
+	if !found {
+		writeNotFound(w, r.PathValue("id"))
+		return
+	}
+	writeJSON(w, http.StatusOK, role)
+}
+
+// updateRole only accepts the fields Logto lets you patch, scopes in
+// particular must be managed through the dedicated endpoints.
+func (s *Server) updateRole(w http.ResponseWriter, r *http.Request) {
+	id := r.PathValue("id")
+	role, found := s.roles.get(id)
+	if !found {
+		writeNotFound(w, id)
+		return
+	}
+	body, ok := readBody(w, r)
+	if !ok {
+		return
+	}
+	if !s.checkRoleUniqueness(w, id, body) {
+		return
+	}
+
+	merge(role, pick(body, "name", "description", "isDefault"))
+	writeJSON(w, http.StatusOK, role)
+}
+
+func (s *Server) deleteRole(w http.ResponseWriter, r *http.Request) {
+	id := r.PathValue("id")
+	if !s.roles.delete(id) {
+		writeNotFound(w, id)
+		return
+	}
+	delete(s.roleScopes, id)
+	for userID, roleIDs := range s.userRoles {
+		s.userRoles[userID] = slices.DeleteFunc(roleIDs, func(r string) bool { return r == id })
+	}
+	for appID, roleIDs := range s.applicationRoles {
+		s.applicationRoles[appID] = slices.DeleteFunc(roleIDs, func(r string) bool { return r == id })
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *Server) checkRoleUniqueness(w http.ResponseWriter, id string, body object) bool {
+	name, found := body["name"]
+	if !found {
+		return true
+	}
+	_, conflict := s.roles.find(func(o object) bool {
+		return o["id"] != id && o["name"] == name
+	})
+	if conflict {
+		writeError(w, http.StatusUnprocessableEntity, "role.name_in_use", fmt.Sprintf("This role name %s is already in use", name))
+		return false
+	}
+	return true
+}
+
+func (s *Server) listRoleScopes(w http.ResponseWriter, r *http.Request) {
+	id := r.PathValue("id")
+	if _, found := s.roles.get(id); !found {
+		writeNotFound(w, id)
+		return
+	}
+
+	scopes := []object{}
+	for _, scopeID := range s.roleScopes[id] {
+		if scope, found := s.scopes.get(scopeID); found {
+			scopes = append(scopes, s.withResource(scope))
+		}
+	}
+	writeJSON(w, http.StatusOK, paginate(w, r, scopes))
+}
+
+// assignRoleScopes assigns the scopes to the role. Like Logto, it fails if
+// one of the scopes is already assigned.
+func (s *Server) assignRoleScopes(w http.ResponseWriter, r *http.Request) {
+	id := r.PathValue("id")
+	if _, found := s.roles.get(id); !found {
+		writeNotFound(w, id)
+		return
+	}
+	body, ok := readBody(w, r)
+	if !ok {
+		return
+	}
+
+	scopeIDs, ok := stringSlice(body["scopeIds"])
+	if !ok {
+		writeError(w, http.StatusBadRequest, "guard.invalid_input", "scopeIds should be an array of strings")
+		return
+	}
+	for _, scopeID := range scopeIDs {
+		if _, found := s.scopes.get(scopeID); !found {
+			writeNotFound(w, scopeID)
+			return
+		}
+		if slices.Contains(s.roleScopes[id], scopeID) {
+			writeError(w, http.StatusUnprocessableEntity, "role.scope_exists", fmt.Sprintf("The scope id %s has already been added to this role.", scopeID))
+			return
+		}
+	}
+
+	s.roleScopes[id] = append(s.roleScopes[id], scopeIDs...)
+	scopes := []object{}
+	for _, scopeID := range scopeIDs {
+		scope, _ := s.scopes.get(scopeID)
+		scopes = append(scopes, scope)
+	}
+	writeJSON(w, http.StatusCreated, scopes)
+}
+
+func (s *Server) deleteRoleScope(w http.ResponseWriter, r *http.Request) {
+	id, scopeID := r.PathValue("id"), r.PathValue("scopeId")
+	if _, found := s.roles.get(id); !found {
+		writeNotFound(w, id)
+		return
+	}
+	if !slices.Contains(s.roleScopes[id], scopeID) {
+		writeNotFound(w, scopeID)
+		return
+	}
+
+	s.roleScopes[id] = slices.DeleteFunc(s.roleScopes[id], func(s string) bool { return s == scopeID })
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *Server) listRoleUsers(w http.ResponseWriter, r *http.Request) {
+	id := r.PathValue("id")
+	if _, found := s.roles.get(id); !found {
+		writeNotFound(w, id)
+		return
+	}
+
+	users := s.users.list(func(o object) bool {
+		return slices.Contains(s.userRoles[o["id"].(string)], id)
+	})
+	writeJSON(w, http.StatusOK, paginate(w, r, users))
+}
+
+// assignRoleUsers assigns the role to the users. Like Logto, only roles of
+// type User can be assigned to users.
+func (s *Server) assignRoleUsers(w http.ResponseWriter, r *http.Request) {
+	id := r.PathValue("id")
+	userIDs, ok := s.readRoleMembers(w, r, id, "User", "userIds", s.users)
+	if !ok {
+		return
+	}
+
+	for _, userID := range userIDs {
+		if !slices.Contains(s.userRoles[userID], id) {
+			s.userRoles[userID] = append(s.userRoles[userID], id)
+		}
+	}
+	w.WriteHeader(http.StatusCreated)
+}
+
+func (s *Server) deleteRoleUser(w http.ResponseWriter, r *http.Request) {
+	id, userID := r.PathValue("id"), r.PathValue("userId")
+	if _, found := s.roles.get(id); !found {
+		writeNotFound(w, id)
+		return
+	}
+	if !slices.Contains(s.userRoles[userID], id) {
+		writeNotFound(w, userID)
+		return
+	}
+
+	s.userRoles[userID] = slices.DeleteFunc(s.userRoles[userID], func(r string) bool { return r == id })
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *Server) listRoleApplications(w http.ResponseWriter, r *http.Request) {
+	id := r.PathValue("id")
+	if _, found := s.roles.get(id); !found {
+		writeNotFound(w, id)
+		return
+	}
+
+	apps := s.applications.list(func(o object) bool {
+		return slices.Contains(s.applicationRoles[o["id"].(string)], id)
+	})
+	writeJSON(w, http.StatusOK, paginate(w, r, apps))
+}
+
+// assignRoleApplications assigns the role to the applications. Like Logto,
+// only machine-to-machine roles can be assigned to applications.
+func (s *Server) assignRoleApplications(w http.ResponseWriter, r *http.Request) {
+	id := r.PathValue("id")
+	appIDs, ok := s.readRoleMembers(w, r, id, "MachineToMachine", "applicationIds", s.applications)
+	if !ok {
+		return
+	}
+
+	for _, appID := range appIDs {
+		if !slices.Contains(s.applicationRoles[appID], id) {
+			s.applicationRoles[appID] = append(s.applicationRoles[appID], id)
+		}
+	}
+	w.WriteHeader(http.StatusCreated)
+}
+
+func (s *Server) deleteRoleApplication(w http.ResponseWriter, r *http.Request) {
+	id, appID := r.PathValue("id"), r.PathValue("applicationId")
+	if _, found := s.roles.get(id); !found {
+		writeNotFound(w, id)
+		return
+	}
+	if !slices.Contains(s.applicationRoles[appID], id) {
+		writeNotFound(w, appID)
+		return
+	}
+
+	s.applicationRoles[appID] = slices.DeleteFunc(s.applicationRoles[appID], func(r string) bool { return r == id })
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// readRoleMembers decodes the identifiers found under key in the body and
+// makes sure that the role has the expected type and that all the members
+// exist.
+func (s *Server) readRoleMembers(w http.ResponseWriter, r *http.Request, id, roleType, key string, members *collection) ([]string, bool) {
+	role, found := s.roles.get(id)
+	if !found {
+		writeNotFound(w, id)
+		return nil, false
+	}
+	if role["type"] != roleType {
+		writeError(w, http.StatusUnprocessableEntity, "role.type_mismatch", fmt.Sprintf("The role type should be %s.", roleType))
+		return nil, false
+	}
+	body, ok := readBody(w, r)
+	if !ok {
+		return nil, false
+	}
+
+	ids, ok := stringSlice(body[key])
+	if !ok {
+		writeError(w, http.StatusBadRequest, "guard.invalid_input", key+" should be an array of strings")
+		return nil, false
+	}
+	for _, memberID := range ids {
+		if _, found := members.get(memberID); !found {
+			writeNotFound(w, memberID)
+			return nil, false
+		}
+	}
+	return ids, true
+}
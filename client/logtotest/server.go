@@ -0,0 +1,374 @@
+// Package logtotest provides an in-process fake of the Logto Management API
+// so the client and the provider can be tested without a live tenant.
+package logtotest
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// TenantID is the tenant every object created on the fake server belongs to.
+	TenantID = "default"
+
+	tokenTTL = time.Hour
+)
+
+type object = map[string]any
+
+// Server is a stateful fake of the Logto Management API. It only implements
+// the subset of the API used by the provider.
+type Server struct {
+	*httptest.Server
+
+	ApplicationID     string
+	ApplicationSecret string
+
+	mu     sync.Mutex
+	tokens map[string]time.Time
+
+	applications     *collection
+	secrets          map[string][]object
+	applicationRoles map[string][]string
+	users            *collection
+	userRoles        map[string][]string
+	roles            *collection
+	roleScopes       map[string][]string
+	resources        *collection
+	scopes           *collection
+	organizations    *collection
+
+	organizationMembers map[string]map[string][]string
+
+	organizationRoles              *collection
+	organizationRoleScopes         map[string][]string
+	organizationRoleResourceScopes map[string][]string
+	organizationScopes             *collection
+}
+
+// NewServer starts a new fake Logto server over TLS. The caller must call
+// Close when done with it.
+func NewServer() *Server {
+	s := &Server{
+		ApplicationID:     "m2m-" + newID(),
+		ApplicationSecret: newID() + newID(),
+
+		tokens:           map[string]time.Time{},
+		applications:     newCollection(),
+		secrets:          map[string][]object{},
+		applicationRoles: map[string][]string{},
+		users:            newCollection(),
+		userRoles:        map[string][]string{},
+		roles:            newCollection(),
+		roleScopes:       map[string][]string{},
+		resources:        newCollection(),
+		scopes:           newCollection(),
+		organizations:    newCollection(),
+
+		organizationMembers: map[string]map[string][]string{},
+
+		organizationRoles:              newCollection(),
+		organizationRoleScopes:         map[string][]string{},
+		organizationRoleResourceScopes: map[string][]string{},
+		organizationScopes:             newCollection(),
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("POST /oidc/token", s.handleToken)
+	s.registerApplications(mux)
+	s.registerApplicationRoles(mux)
+	s.registerUsers(mux)
+	s.registerRoles(mux)
+	s.registerResources(mux)
+	s.registerOrganizations(mux)
+	s.registerOrganizationUsers(mux)
+	s.registerOrganizationRoles(mux)
+	s.registerOrganizationScopes(mux)
+
+	s.Server = httptest.NewTLSServer(s.authenticate(mux))
+	return s
+}
+
+// Hostname returns the value to use as the Logto hostname to reach the
+// server. The http.Client returned by Client must be used as it trusts the
+// certificate of the server.
+func (s *Server) Hostname() string {
+	return s.Listener.Addr().String()
+}
+
+func (s *Server) handleToken(w http.ResponseWriter, r *http.Request) {
+	id, secret, ok := r.BasicAuth()
+	if !ok || id != s.ApplicationID || secret != s.ApplicationSecret {
+		writeError(w, http.StatusUnauthorized, "oidc.invalid_client", "invalid client credentials")
+		return
+	}
+	if err := r.ParseForm(); err != nil {
+		writeError(w, http.StatusBadRequest, "oidc.invalid_request", err.Error())
+		return
+	}
+	if grant := r.PostForm.Get("grant_type"); grant != "client_credentials" {
+		writeError(w, http.StatusBadRequest, "oidc.unsupported_grant_type", fmt.Sprintf("unsupported grant type %q", grant))
+		return
+	}
+
+	token := newID() + newID()
+	s.mu.Lock()
+	s.tokens[token] = time.Now().Add(tokenTTL)
+	s.mu.Unlock()
+
+	writeJSON(w, http.StatusOK, object{
+		"access_token": token,
+		"expires_in":   int(tokenTTL.Seconds()),
+		"token_type":   "Bearer",
+		"scope":        r.PostForm.Get("scope"),
+	})
+}
+
+// authenticate rejects the calls to the Management API that do not carry a
+// valid access token.
+func (s *Server) authenticate(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !strings.HasPrefix(r.URL.Path, "/api/") {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		token, found := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+		s.mu.Lock()
+		expires, valid := s.tokens[token]
+		s.mu.Unlock()
+		if !found || !valid || time.Now().After(expires) {
+			writeError(w, http.StatusUnauthorized, "auth.unauthorized", "invalid or expired access token")
+			return
+		}
+
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		next.ServeHTTP(w, r)
+	})
+}
+
+// collection stores objects by ID while remembering their insertion order so
+// that list endpoints are stable.
+type collection struct {
+	ids   []string
+	items map[string]object
+}
+
+func newCollection() *collection {
+	return &collection{items: map[string]object{}}
+}
+
+func (c *collection) get(id string) (object, bool) {
+	o, found := c.items[id]
+	return o, found
+}
+
+func (c *collection) put(o object) {
+	id := o["id"].(string)
+	if _, found := c.items[id]; !found {
+		c.ids = append(c.ids, id)
+	}
+	c.items[id] = o
+}
+
+func (c *collection) delete(id string) bool {
+	if _, found := c.items[id]; !found {
+		return false
+	}
+	delete(c.items, id)
+	c.ids = slices.DeleteFunc(c.ids, func(i string) bool { return i == id })
+	return true
+}
+
+func (c *collection) list(filter func(object) bool) []object {
+	res := []object{}
+	for _, id := range c.ids {
+		if o := c.items[id]; filter == nil || filter(o) {
+			res = append(res, o)
+		}
+	}
+	return res
+}
+
+func (c *collection) find(filter func(object) bool) (object, bool) {
+	for _, o := range c.list(filter) {
+		return o, true
+	}
+	return nil, false
+}
+
+// paginate applies the page and page_size query parameters the same way Logto
+// does, setting the Total-Number header when pagination is requested.
+func paginate(w http.ResponseWriter, r *http.Request, items []object) []object {
+	query := r.URL.Query()
+	if !query.Has("page") && !query.Has("page_size") {
+		return items
+	}
+
+	page, err := strconv.Atoi(query.Get("page"))
+	if err != nil || page < 1 {
+		page = 1
+	}
+	size, err := strconv.Atoi(query.Get("page_size"))
+	if err != nil || size < 1 {
+		size = 20
+	}
+
+	w.Header().Set("Total-Number", strconv.Itoa(len(items)))
+
+	start := min((page-1)*size, len(items))
+	end := min(start+size, len(items))
+	return items[start:end]
+}
+
+// search returns a filter applying the search query parameters of Logto to
+// the given fields of the objects, or nil when there is nothing to search.
+// The similar_to mode is handled as like, the patterns tested are simple
+// enough for both to behave the same.
+func search(r *http.Request, fields ...string) func(object) bool {
+	query := r.URL.Query()
+	caseSensitive := query.Get("isCaseSensitive") == "true"
+	all := query.Get("joint") == "and"
+
+	type condition struct {
+		fields []string
+		value  string
+		mode   string
+	}
+	var conditions []condition
+	if query.Has("search") {
+		conditions = append(conditions, condition{fields, query.Get("search"), query.Get("mode")})
+	}
+	for _, field := range fields {
+		if !query.Has("search." + field) {
+			continue
+		}
+		mode := query.Get("mode." + field)
+		if mode == "" {
+			mode = query.Get("mode")
+		}
+		conditions = append(conditions, condition{[]string{field}, query.Get("search." + field), mode})
+	}
+	if len(conditions) == 0 {
+		return nil
+	}
+
+	return func(o object) bool {
+		for _, c := range conditions {
+			matched := slices.ContainsFunc(c.fields, func(field string) bool {
+				value, ok := o[field].(string)
+				if !ok {
+					return false
+				}
+				pattern := c.value
+				if !caseSensitive {
+					value, pattern = strings.ToLower(value), strings.ToLower(pattern)
+				}
+				switch c.mode {
+				case "exact":
+					return value == pattern
+				case "posix":
+					matched, _ := regexp.MatchString(pattern, value)
+					return matched
+				default:
+					return like(value, pattern)
+				}
+			})
+			if matched != all {
+				return matched
+			}
+		}
+		return all
+	}
+}
+
+// like reports whether value matches the SQL pattern, where % matches any
+// sequence of characters and _ any single character.
+func like(value, pattern string) bool {
+	var re strings.Builder
+	re.WriteString("(?s)^")
+	for _, r := range pattern {
+		switch r {
+		case '%':
+			re.WriteString(".*")
+		case '_':
+			re.WriteString(".")
+		default:
+			re.WriteString(regexp.QuoteMeta(string(r)))
+		}
+	}
+	re.WriteString("$")
+	return regexp.MustCompile(re.String()).MatchString(value)
+}
+
+func newID() string {
+	b := make([]byte, 11)
+	_, _ = rand.Read(b)
+	return hex.EncodeToString(b)[:21]
+}
+
+func now() float64 {
+	return float64(time.Now().UnixMilli())
+}
+
+// merge applies a PATCH body to an object. Nested objects are merged
+// recursively while every other value is replaced.
+func merge(dst, patch object) {
+	for key, value := range patch {
+		nested, ok := value.(object)
+		existing, found := dst[key].(object)
+		if ok && found {
+			merge(existing, nested)
+			continue
+		}
+		dst[key] = value
+	}
+}
+
+// pick returns a copy of the object keeping only the given keys.
+func pick(o object, keys ...string) object {
+	res := object{}
+	for _, key := range keys {
+		if value, found := o[key]; found {
+			res[key] = value
+		}
+	}
+	return res
+}
+
+func readBody(w http.ResponseWriter, r *http.Request) (object, bool) {
+	body := object{}
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		writeError(w, http.StatusBadRequest, "guard.invalid_input", err.Error())
+		return nil, false
+	}
+	return body, true
+}
+
+func writeJSON(w http.ResponseWriter, status int, body any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(body)
+}
+
+func writeError(w http.ResponseWriter, status int, code, message string) {
+	writeJSON(w, status, object{
+		"code":    code,
+		"message": message,
+	})
+}
+
+func writeNotFound(w http.ResponseWriter, id string) {
+	writeError(w, http.StatusNotFound, "entity.not_exists_with_id", fmt.Sprintf("The entity with ID `%s` does not exist.", id))
+}
@@ -0,0 +1,370 @@
+package logtotest
+
+import (
+	"fmt"
+	"maps"
+	"net/http"
+	"regexp"
+	"slices"
+)
+
+// passwordAlgorithms are the algorithms accepted by Logto for password digests.
+var passwordAlgorithms = []string{"Argon2i", "Argon2id", "Argon2d", "SHA1", "SHA256", "MD5", "Bcrypt"}
+
+var phoneRegexp = regexp.MustCompile(`^\d+$`)
+
+func (s *Server) registerUsers(mux *http.ServeMux) {
+	mux.HandleFunc("GET /api/users", s.listUsers)
+	mux.HandleFunc("POST /api/users", s.createUser)
+	mux.HandleFunc("GET /api/users/{id}", s.getUser)
+	mux.HandleFunc("PATCH /api/users/{id}", s.updateUser)
+	mux.HandleFunc("DELETE /api/users/{id}", s.deleteUser)
+	mux.HandleFunc("PATCH /api/users/{id}/password", s.updateUserPassword)
+	mux.HandleFunc("PATCH /api/users/{id}/is-suspended", s.updateUserIsSuspended)
+	mux.HandleFunc("PUT /api/users/{id}/identities/{target}", s.updateUserIdentity)
+	mux.HandleFunc("DELETE /api/users/{id}/identities/{target}", s.deleteUserIdentity)
+	mux.HandleFunc("GET /api/users/{id}/roles", s.listUserRoles)
+	mux.HandleFunc("POST /api/users/{id}/roles", s.assignUserRoles)
+	mux.HandleFunc("PUT /api/users/{id}/roles", s.replaceUserRoles)
+	mux.HandleFunc("DELETE /api/users/{id}/roles/{roleId}", s.deleteUserRole)
+}
+
+func (s *Server) listUsers(w http.ResponseWriter, r *http.Request) {
+	filter := search(r, "id", "username", "primaryEmail", "primaryPhone", "name")
+	writeJSON(w, http.StatusOK, paginate(w, r, s.users.list(filter)))
+}
+
+func (s *Server) createUser(w http.ResponseWriter, r *http.Request) {
+	body, ok := readBody(w, r)
+	if !ok {
+		return
+	}
+	if !s.checkUserUniqueness(w, "", body) || !checkPhone(w, body, false) {
+		return
+	}
+
+	user := object{
+		"id":            newID(),
+		"username":      nil,
+		"primaryEmail":  nil,
+		"primaryPhone":  nil,
+		"name":          nil,
+		"avatar":        nil,
+		"customData":    object{},
+		"identities":    object{},
+		"profile":       object{},
+		"applicationId": nil,
+		"isSuspended":   false,
+		"hasPassword":   false,
+		"lastSignInAt":  nil,
+		"createdAt":     now(),
+		"updatedAt":     now(),
+	}
+	merge(user, pick(body, "username", "primaryEmail", "primaryPhone", "name", "avatar", "customData", "profile"))
+
+	password, _ := body["password"].(string)
+	digest, _ := body["passwordDigest"].(string)
+	algorithm, _ := body["passwordAlgorithm"].(string)
+	switch {
+	case password != "" && digest != "":
+		writeError(w, http.StatusBadRequest, "guard.invalid_input", "password and passwordDigest cannot be used together")
+		return
+	case digest != "" && !slices.Contains(passwordAlgorithms, algorithm):
+		writeError(w, http.StatusBadRequest, "guard.invalid_input", fmt.Sprintf("invalid passwordAlgorithm %q", algorithm))
+		return
+	}
+	user["hasPassword"] = password != "" || digest != ""
+	s.users.put(user)
+
+	writeJSON(w, http.StatusOK, user)
+}
+
+func (s *Server) getUser(w http.ResponseWriter, r *http.Request) {
+	user, found := s.users.get(r.PathValue("id"))
+	if !found {
+		writeNotFound(w, r.PathValue("id"))
+		return
+	}
+	if r.URL.Query().Get("includeSsoIdentities") == "true" {
+		// The fake has no enterprise SSO connectors, so users never have SSO
+		// identities.
+		user = maps.Clone(user)
+		user["ssoIdentities"] = []object{}
+	}
+	writeJSON(w, http.StatusOK, user)
+}
+
+func (s *Server) updateUser(w http.ResponseWriter, r *http.Request) {
+	id := r.PathValue("id")
+	user, found := s.users.get(id)
+	if !found {
+		writeNotFound(w, id)
+		return
+	}
+	body, ok := readBody(w, r)
+	if !ok {
+		return
+	}
+	if !s.checkUserUniqueness(w, id, body) || !checkPhone(w, body, true) {
+		return
+	}
+
+	merge(user, pick(body, "username", "primaryEmail", "primaryPhone", "name", "avatar"))
+	for _, key := range []string{"primaryPhone", "avatar"} {
+		if user[key] == "" {
+			user[key] = nil
+		}
+	}
+	// Logto replaces the profile instead of merging it.
+	if profile, found := body["profile"]; found {
+		user["profile"] = profile
+	}
+	// Logto replaces the custom data instead of merging it.
+	if customData, found := body["customData"]; found {
+		user["customData"] = customData
+	}
+	user["updatedAt"] = now()
+	writeJSON(w, http.StatusOK, user)
+}
+
+func (s *Server) updateUserPassword(w http.ResponseWriter, r *http.Request) {
+	id := r.PathValue("id")
+	user, found := s.users.get(id)
+	if !found {
+		writeNotFound(w, id)
+		return
+	}
+	body, ok := readBody(w, r)
+	if !ok {
+		return
+	}
+	if password, _ := body["password"].(string); password == "" {
+		writeError(w, http.StatusBadRequest, "guard.invalid_input", "password is required")
+		return
+	}
+
+	user["hasPassword"] = true
+	user["updatedAt"] = now()
+	writeJSON(w, http.StatusOK, user)
+}
+
+func (s *Server) updateUserIsSuspended(w http.ResponseWriter, r *http.Request) {
+	id := r.PathValue("id")
+	user, found := s.users.get(id)
+	if !found {
+		writeNotFound(w, id)
+		return
+	}
+	body, ok := readBody(w, r)
+	if !ok {
+		return
+	}
+	suspended, ok := body["isSuspended"].(bool)
+	if !ok {
+		writeError(w, http.StatusBadRequest, "guard.invalid_input", "isSuspended is required")
+		return
+	}
+
+	user["isSuspended"] = suspended
+	user["updatedAt"] = now()
+	writeJSON(w, http.StatusOK, user)
+}
+
+func (s *Server) updateUserIdentity(w http.ResponseWriter, r *http.Request) {
+	id := r.PathValue("id")
+	user, found := s.users.get(id)
+	if !found {
+		writeNotFound(w, id)
+		return
+	}
+	body, ok := readBody(w, r)
+	if !ok {
+		return
+	}
+	userID, _ := body["userId"].(string)
+	if userID == "" {
+		writeError(w, http.StatusBadRequest, "guard.invalid_input", "userId is required")
+		return
+	}
+
+	identity := object{"userId": userID}
+	if details, found := body["details"]; found {
+		if _, ok := details.(object); !ok {
+			writeError(w, http.StatusBadRequest, "guard.invalid_input", "details must be an object")
+			return
+		}
+		identity["details"] = details
+	}
+
+	identities := user["identities"].(object)
+	status := http.StatusOK
+	if _, found := identities[r.PathValue("target")]; !found {
+		status = http.StatusCreated
+	}
+	identities[r.PathValue("target")] = identity
+	user["updatedAt"] = now()
+	writeJSON(w, status, identities)
+}
+
+func (s *Server) deleteUserIdentity(w http.ResponseWriter, r *http.Request) {
+	id := r.PathValue("id")
+	user, found := s.users.get(id)
+	if !found {
+		writeNotFound(w, id)
+		return
+	}
+
+	identities := user["identities"].(object)
+	target := r.PathValue("target")
+	if _, found := identities[target]; !found {
+		writeError(w, http.StatusNotFound, "user.identity_not_exist", fmt.Sprintf("The identity %s does not exist.", target))
+		return
+	}
+	delete(identities, target)
+	user["updatedAt"] = now()
+	writeJSON(w, http.StatusOK, identities)
+}
+
+func (s *Server) deleteUser(w http.ResponseWriter, r *http.Request) {
+	id := r.PathValue("id")
+	if !s.users.delete(id) {
+		writeNotFound(w, id)
+		return
+	}
+	delete(s.userRoles, id)
+	for _, members := range s.organizationMembers {
+		delete(members, id)
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// checkPhone mimics Logto only accepting phone numbers made of digits, empty
+// phone numbers remove the phone number of existing users.
+func checkPhone(w http.ResponseWriter, body object, allowEmpty bool) bool {
+	value, found := body["primaryPhone"]
+	if !found || value == nil {
+		return true
+	}
+	phone, _ := value.(string)
+	if (phone == "" && allowEmpty) || phoneRegexp.MatchString(phone) {
+		return true
+	}
+	writeError(w, http.StatusBadRequest, "guard.invalid_input", fmt.Sprintf("invalid primaryPhone %q", phone))
+	return false
+}
+
+// checkUserUniqueness mimics Logto refusing two users sharing the same
+// username or primary email.
+func (s *Server) checkUserUniqueness(w http.ResponseWriter, id string, body object) bool {
+	for _, key := range []string{"username", "primaryEmail", "primaryPhone"} {
+		value, found := body[key]
+		if !found || value == nil || value == "" {
+			continue
+		}
+		_, conflict := s.users.find(func(o object) bool {
+			return o["id"] != id && o[key] == value
+		})
+		if conflict {
+			writeError(w, http.StatusUnprocessableEntity, "user."+key+"_already_in_use", fmt.Sprintf("This %s is already in use.", key))
+			return false
+		}
+	}
+	return true
+}
+
+func (s *Server) listUserRoles(w http.ResponseWriter, r *http.Request) {
+	id := r.PathValue("id")
+	if _, found := s.users.get(id); !found {
+		writeNotFound(w, id)
+		return
+	}
+
+	roles := []object{}
+	for _, roleID := range s.userRoles[id] {
+		if role, found := s.roles.get(roleID); found {
+			roles = append(roles, role)
+		}
+	}
+	writeJSON(w, http.StatusOK, paginate(w, r, roles))
+}
+
+func (s *Server) assignUserRoles(w http.ResponseWriter, r *http.Request) {
+	id := r.PathValue("id")
+	roleIDs, ok := s.readUserRoles(w, r, id)
+	if !ok {
+		return
+	}
+
+	for _, roleID := range roleIDs {
+		if !slices.Contains(s.userRoles[id], roleID) {
+			s.userRoles[id] = append(s.userRoles[id], roleID)
+		}
+	}
+	w.WriteHeader(http.StatusCreated)
+}
+
+func (s *Server) replaceUserRoles(w http.ResponseWriter, r *http.Request) {
+	id := r.PathValue("id")
+	roleIDs, ok := s.readUserRoles(w, r, id)
+	if !ok {
+		return
+	}
+
+	s.userRoles[id] = roleIDs
+	w.WriteHeader(http.StatusOK)
+}
+
+func (s *Server) deleteUserRole(w http.ResponseWriter, r *http.Request) {
+	id, roleID := r.PathValue("id"), r.PathValue("roleId")
+	if _, found := s.users.get(id); !found {
+		writeNotFound(w, id)
+		return
+	}
+	if !slices.Contains(s.userRoles[id], roleID) {
+		writeNotFound(w, roleID)
+		return
+	}
+
+	s.userRoles[id] = slices.DeleteFunc(s.userRoles[id], func(r string) bool { return r == roleID })
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *Server) readUserRoles(w http.ResponseWriter, r *http.Request, id string) ([]string, bool) {
+	if _, found := s.users.get(id); !found {
+		writeNotFound(w, id)
+		return nil, false
+	}
+	body, ok := readBody(w, r)
+	if !ok {
+		return nil, false
+	}
+
+	roleIDs, ok := stringSlice(body["roleIds"])
+	if !ok {
+		writeError(w, http.StatusBadRequest, "guard.invalid_input", "roleIds should be an array of strings")
+		return nil, false
+	}
+	for _, roleID := range roleIDs {
+		if _, found := s.roles.get(roleID); !found {
+			writeNotFound(w, roleID)
+			return nil, false
+		}
+	}
+	return roleIDs, true
+}
+
+func stringSlice(value any) ([]string, bool) {
+	values, ok := value.([]any)
+	if !ok {
+		return nil, false
+	}
+	res := make([]string, 0, len(values))
+	for _, v := range values {
+		s, ok := v.(string)
+		if !ok {
+			return nil, false
+		}
+		res = append(res, s)
+	}
+	return res, true
+}
@@ -1,67 +1,146 @@
 package client
 
+import "encoding/json"
+
 type OidcClientMetadata struct {
 	RedirectUris                     []string `json:"redirectUris"`
 	PostLogoutRedirectUris           []string `json:"postLogoutRedirectUris"`
 	BackchannelLogoutUri             string   `json:"backchannelLogoutUri,omitempty"`
-	BackchannelLogoutSessionRequired bool     `json:"backchannelLogoutSessionRequired,omitempty"`
+	BackchannelLogoutSessionRequired *bool    `json:"backchannelLogoutSessionRequired,omitempty"`
 	LogoUri                          string   `json:"logoUri,omitempty"`
 }
 
 type CustomClientMetadata struct {
 	CorsAllowedOrigins      []string `json:"corsAllowedOrigins,omitempty"`
-	IdTokenTtl              float64  `json:"idTokenTtl,omitempty"`
+	IdTokenTtl              *float64 `json:"idTokenTtl,omitempty"`
 	RefreshTokenTtl         float64  `json:"refreshTokenTtl,omitempty"`
-	RefreshTokenTtlInDays   float64  `json:"refreshTokenTtlInDays,omitempty"`
+	RefreshTokenTtlInDays   *float64 `json:"refreshTokenTtlInDays,omitempty"`
 	TenantId                string   `json:"tenantId,omitempty"`
-	AlwaysIssueRefreshToken bool     `json:"alwaysIssueRefreshToken,omitempty"`
-	RotateRefreshToken      bool     `json:"rotateRefreshToken,omitempty"`
+	AlwaysIssueRefreshToken *bool    `json:"alwaysIssueRefreshToken,omitempty"`
+	RotateRefreshToken      *bool    `json:"rotateRefreshToken,omitempty"`
 }
 
 type PageRule struct {
 	Path string `json:"path"`
 }
 
+// ProtectedAppMetadata configures an application of type Protected. SubDomain
+// is only accepted on creation, and Host and CustomDomains are read-only.
 type ProtectedAppMetadata struct {
-	Origin          string     `json:"origin"`
-	SessionDuration float64    `json:"sessionDuration"`
-	PageRules       []PageRule `json:"pageRules"`
+	Host            string         `json:"host,omitempty"`
+	SubDomain       string         `json:"subDomain,omitempty"`
+	Origin          string         `json:"origin"`
+	SessionDuration float64        `json:"sessionDuration,omitempty"`
+	PageRules       *[]PageRule    `json:"pageRules,omitempty"`
+	CustomDomains   []CustomDomain `json:"customDomains,omitempty"`
+}
+
+type CustomDomain struct {
+	Domain       string `json:"domain"`
+	Status       string `json:"status,omitempty"`
+	ErrorMessage string `json:"errorMessage,omitempty"`
 }
 
 type ApplicationModel struct {
-	TenantId             string                 `json:"tenantId,omitempty"`
-	ID                   string                 `json:"id,omitempty"`
-	Name                 string                 `json:"name"`
-	Description          string                 `json:"description,omitempty"`
-	Type                 string                 `json:"type"`
-	OidcClientMetadata   *OidcClientMetadata    `json:"oidcClientMetadata,omitempty"`
-	CustomClientMetadata *CustomClientMetadata  `json:"customClientMetadata,omitempty"`
-	CustomData           map[string]interface{} `json:"customData,omitempty"`
-	ProtectedAppMetadata *ProtectedAppMetadata  `json:"protectedAppMetadata,omitempty"`
-	IsAdmin              bool                   `json:"isAdmin"`
-	IsThirdParty         bool                   `json:"isThirdParty"`
+	TenantId             string                `json:"tenantId,omitempty"`
+	ID                   string                `json:"id,omitempty"`
+	Name                 string                `json:"name"`
+	Description          string                `json:"description,omitempty"`
+	Type                 string                `json:"type"`
+	OidcClientMetadata   *OidcClientMetadata   `json:"oidcClientMetadata,omitempty"`
+	CustomClientMetadata *CustomClientMetadata `json:"customClientMetadata,omitempty"`
+	CustomData           json.RawMessage       `json:"customData,omitempty"`
+	ProtectedAppMetadata *ProtectedAppMetadata `json:"protectedAppMetadata,omitempty"`
+	IsAdmin              bool                  `json:"isAdmin"`
+	IsThirdParty         bool                  `json:"isThirdParty"`
 }
 
 type UserModel struct {
-	ID           string   `json:"id,omitempty"`
-	PrimaryEmail string   `json:"primaryEmail,omitempty"`
-	Username     string   `json:"username,omitempty"`
-	Name         string   `json:"name,omitempty"`
-	Profile      *Profile `json:"profile,omitempty"`
+	ID           string          `json:"id,omitempty"`
+	PrimaryEmail string          `json:"primaryEmail,omitempty"`
+	Username     string          `json:"username,omitempty"`
+	Name         string          `json:"name,omitempty"`
+	Profile      *Profile        `json:"profile,omitempty"`
+	CustomData   json.RawMessage `json:"customData,omitempty"`
+
+	// PrimaryPhone holds the digits of an E.164 phone number without the
+	// leading "+". PrimaryPhone and Avatar are left untouched by UserUpdate
+	// when nil and removed when empty.
+	PrimaryPhone *string `json:"primaryPhone,omitempty"`
+	Avatar       *string `json:"avatar,omitempty"`
+
+	// These fields are read-only, use UserIsSuspendedUpdate to suspend a
+	// user and UserIdentityUpdate to link an identity. SsoIdentities is only
+	// returned by UserGetWithSsoIdentities.
+	IsSuspended   bool                     `json:"isSuspended,omitempty"`
+	HasPassword   bool                     `json:"hasPassword,omitempty"`
+	CreatedAt     *float64                 `json:"createdAt,omitempty"`
+	UpdatedAt     *float64                 `json:"updatedAt,omitempty"`
+	LastSignInAt  *float64                 `json:"lastSignInAt,omitempty"`
+	Identities    map[string]IdentityModel `json:"identities,omitempty"`
+	SsoIdentities []SsoIdentityModel       `json:"ssoIdentities,omitempty"`
+
+	// The password can only be set when the user is created, use
+	// UserPasswordUpdate to change it afterwards. PasswordDigest and
+	// PasswordAlgorithm import a password hashed by another system.
+	Password          string `json:"password,omitempty"`
+	PasswordDigest    string `json:"passwordDigest,omitempty"`
+	PasswordAlgorithm string `json:"passwordAlgorithm,omitempty"`
+}
+
+// IdentityModel is an identity of a user at a social identity provider, the
+// identities of a user are indexed by the target of their connector.
+type IdentityModel struct {
+	UserID  string          `json:"userId"`
+	Details json.RawMessage `json:"details,omitempty"`
 }
 
+// SsoIdentityModel is an identity of a user at an enterprise identity
+// provider.
+type SsoIdentityModel struct {
+	ID             string          `json:"id,omitempty"`
+	UserID         string          `json:"userId,omitempty"`
+	Issuer         string          `json:"issuer,omitempty"`
+	IdentityID     string          `json:"identityId,omitempty"`
+	Detail         json.RawMessage `json:"detail,omitempty"`
+	SsoConnectorID string          `json:"ssoConnectorId,omitempty"`
+	CreatedAt      *float64        `json:"createdAt,omitempty"`
+	UpdatedAt      *float64        `json:"updatedAt,omitempty"`
+}
+
+// Profile holds the standard OpenID Connect claims of a user. Logto replaces
+// the whole profile when a user is updated.
 type Profile struct {
-	FamilyName string `json:"familyName,omitempty"`
-	GivenName  string `json:"givenName,omitempty"`
-	MiddleName string `json:"middleName,omitempty"`
-	Nickname   string `json:"nickname,omitempty"`
+	FamilyName        string   `json:"familyName,omitempty"`
+	GivenName         string   `json:"givenName,omitempty"`
+	MiddleName        string   `json:"middleName,omitempty"`
+	Nickname          string   `json:"nickname,omitempty"`
+	PreferredUsername string   `json:"preferredUsername,omitempty"`
+	Profile           string   `json:"profile,omitempty"`
+	Website           string   `json:"website,omitempty"`
+	Gender            string   `json:"gender,omitempty"`
+	Birthdate         string   `json:"birthdate,omitempty"`
+	Zoneinfo          string   `json:"zoneinfo,omitempty"`
+	Locale            string   `json:"locale,omitempty"`
+	Address           *Address `json:"address,omitempty"`
+}
+
+type Address struct {
+	Formatted     string `json:"formatted,omitempty"`
+	StreetAddress string `json:"streetAddress,omitempty"`
+	Locality      string `json:"locality,omitempty"`
+	Region        string `json:"region,omitempty"`
+	PostalCode    string `json:"postalCode,omitempty"`
+	Country       string `json:"country,omitempty"`
 }
 
 type Secret struct {
-	TenantId      string `json:"tenantId"`
-	ApplicationId string `json:"applicationId"`
-	Name          string `json:"name"`
-	Value         string `json:"value"`
+	TenantId      string   `json:"tenantId,omitempty"`
+	ApplicationId string   `json:"applicationId,omitempty"`
+	Name          string   `json:"name"`
+	Value         string   `json:"value,omitempty"`
+	CreatedAt     *float64 `json:"createdAt,omitempty"`
+	ExpiresAt     *float64 `json:"expiresAt,omitempty"`
 }
 
 type ApiResourceModel struct {
@@ -97,3 +176,63 @@ type RoleModel struct {
 type RoleIdsModel struct {
 	RoleIds []string `json:"roleIds"`
 }
+
+type OrganizationBranding struct {
+	LogoUrl     string `json:"logoUrl,omitempty"`
+	DarkLogoUrl string `json:"darkLogoUrl,omitempty"`
+	Favicon     string `json:"favicon,omitempty"`
+	DarkFavicon string `json:"darkFavicon,omitempty"`
+}
+
+type OrganizationModel struct {
+	TenantId      string                `json:"tenantId,omitempty"`
+	ID            string                `json:"id,omitempty"`
+	Name          string                `json:"name"`
+	Description   string                `json:"description"`
+	CustomData    json.RawMessage       `json:"customData,omitempty"`
+	IsMfaRequired bool                  `json:"isMfaRequired"`
+	Branding      *OrganizationBranding `json:"branding,omitempty"`
+	CreatedAt     *float64              `json:"createdAt,omitempty"`
+}
+
+type OrganizationScopeModel struct {
+	TenantId    string `json:"tenantId,omitempty"`
+	ID          string `json:"id,omitempty"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+}
+
+type OrganizationRoleModel struct {
+	TenantId             string   `json:"tenantId,omitempty"`
+	ID                   string   `json:"id,omitempty"`
+	Name                 string   `json:"name"`
+	Description          string   `json:"description"`
+	Type                 string   `json:"type,omitempty"`
+	OrganizationScopeIds []string `json:"organizationScopeIds,omitempty"`
+	ResourceScopeIds     []string `json:"resourceScopeIds,omitempty"`
+}
+
+type OrganizationScopeIdsModel struct {
+	OrganizationScopeIds []string `json:"organizationScopeIds"`
+}
+
+type ScopeIdsModel struct {
+	ScopeIds []string `json:"scopeIds"`
+}
+
+type UserIdsModel struct {
+	UserIds []string `json:"userIds"`
+}
+
+type ApplicationIdsModel struct {
+	ApplicationIds []string `json:"applicationIds"`
+}
+
+type OrganizationRoleIdsModel struct {
+	OrganizationRoleIds []string `json:"organizationRoleIds"`
+}
+
+type UserOrganizationModel struct {
+	OrganizationModel
+	OrganizationRoles []OrganizationRoleModel `json:"organizationRoles"`
+}
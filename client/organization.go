@@ -0,0 +1,108 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"path"
+)
+
+func (c *Client) OrganizationGet(ctx context.Context, id string) (*OrganizationModel, error) {
+	if id == "" {
+		return nil, errEmptyID
+	}
+
+	req := &request{
+		method: http.MethodGet,
+		path:   path.Join("api/organizations", id),
+	}
+
+	res, err := expect(200, 404)(c.do(ctx, req))
+	if err != nil {
+		return nil, err
+	}
+
+	if res.StatusCode == 404 {
+		return nil, nil
+	}
+
+	var organization OrganizationModel
+	if err := decode(res.Body, &organization); err != nil {
+		return nil, err
+	}
+	return &organization, nil
+}
+
+func (c *Client) OrganizationList(ctx context.Context, query_params map[string]string) ([]OrganizationModel, error) {
+	req := &request{
+		method:          http.MethodGet,
+		path:            "api/organizations",
+		queryParameters: query_params,
+	}
+
+	res, err := expect(200)(c.do(ctx, req))
+	if err != nil {
+		return nil, err
+	}
+
+	var organizations []OrganizationModel
+	if err := decode(res.Body, &organizations); err != nil {
+		return nil, err
+	}
+	return organizations, nil
+}
+
+func (c *Client) OrganizationCreate(ctx context.Context, organization *OrganizationModel) (*OrganizationModel, error) {
+	req := &request{
+		method: http.MethodPost,
+		path:   "api/organizations",
+		body:   organization,
+	}
+
+	res, err := expect(201)(c.do(ctx, req))
+	if err != nil {
+		return nil, err
+	}
+
+	var returnOrganization OrganizationModel
+	if err := decode(res.Body, &returnOrganization); err != nil {
+		return nil, err
+	}
+	return &returnOrganization, nil
+}
+
+func (c *Client) OrganizationDelete(ctx context.Context, id string) error {
+	if id == "" {
+		return errEmptyID
+	}
+
+	req := &request{
+		method: http.MethodDelete,
+		path:   path.Join("api/organizations", id),
+	}
+
+	_, err := expect(204)(c.do(ctx, req))
+	return err
+}
+
+func (c *Client) OrganizationUpdate(ctx context.Context, organization *OrganizationModel) (*OrganizationModel, error) {
+	if organization.ID == "" {
+		return nil, errEmptyID
+	}
+
+	req := &request{
+		method: http.MethodPatch,
+		path:   path.Join("api/organizations", organization.ID),
+		body:   organization,
+	}
+
+	res, err := expect(200)(c.do(ctx, req))
+	if err != nil {
+		return nil, err
+	}
+
+	var returnOrganization OrganizationModel
+	if err := decode(res.Body, &returnOrganization); err != nil {
+		return nil, err
+	}
+	return &returnOrganization, nil
+}
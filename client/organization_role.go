@@ -0,0 +1,190 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"path"
+)
+
+func (c *Client) OrganizationRoleGet(ctx context.Context, id string) (*OrganizationRoleModel, error) {
+	if id == "" {
+		return nil, errEmptyID
+	}
+
+	req := &request{
+		method: http.MethodGet,
+		path:   path.Join("api/organization-roles", id),
+	}
+
+	res, err := expect(200, 404)(c.do(ctx, req))
+	if err != nil {
+		return nil, err
+	}
+
+	if res.StatusCode == 404 {
+		return nil, nil
+	}
+
+	var role OrganizationRoleModel
+	if err := decode(res.Body, &role); err != nil {
+		return nil, err
+	}
+	return &role, nil
+}
+
+func (c *Client) OrganizationRoleList(ctx context.Context, query_params map[string]string) ([]OrganizationRoleModel, error) {
+	req := &request{
+		method:          http.MethodGet,
+		path:            "api/organization-roles",
+		queryParameters: query_params,
+	}
+
+	res, err := expect(200)(c.do(ctx, req))
+	if err != nil {
+		return nil, err
+	}
+
+	var roles []OrganizationRoleModel
+	if err := decode(res.Body, &roles); err != nil {
+		return nil, err
+	}
+	return roles, nil
+}
+
+func (c *Client) OrganizationRoleCreate(ctx context.Context, role *OrganizationRoleModel) (*OrganizationRoleModel, error) {
+	req := &request{
+		method: http.MethodPost,
+		path:   "api/organization-roles",
+		body:   role,
+	}
+
+	res, err := expect(201)(c.do(ctx, req))
+	if err != nil {
+		return nil, err
+	}
+
+	var returnRole OrganizationRoleModel
+	if err := decode(res.Body, &returnRole); err != nil {
+		return nil, err
+	}
+	return &returnRole, nil
+}
+
+func (c *Client) OrganizationRoleDelete(ctx context.Context, id string) error {
+	if id == "" {
+		return errEmptyID
+	}
+
+	req := &request{
+		method: http.MethodDelete,
+		path:   path.Join("api/organization-roles", id),
+	}
+
+	_, err := expect(204)(c.do(ctx, req))
+	return err
+}
+
+func (c *Client) OrganizationRoleUpdate(ctx context.Context, role *OrganizationRoleModel) (*OrganizationRoleModel, error) {
+	if role.ID == "" {
+		return nil, errEmptyID
+	}
+
+	req := &request{
+		method: http.MethodPatch,
+		path:   path.Join("api/organization-roles", role.ID),
+		body:   role,
+	}
+
+	res, err := expect(200)(c.do(ctx, req))
+	if err != nil {
+		return nil, err
+	}
+
+	var returnRole OrganizationRoleModel
+	if err := decode(res.Body, &returnRole); err != nil {
+		return nil, err
+	}
+	return &returnRole, nil
+}
+
+func (c *Client) OrganizationRoleScopesGet(ctx context.Context, roleId string) ([]OrganizationScopeModel, error) {
+	if roleId == "" {
+		return nil, errEmptyID
+	}
+
+	req := &request{
+		method: http.MethodGet,
+		path:   path.Join("api/organization-roles", roleId, "scopes"),
+	}
+
+	res, err := expect(200, 404)(c.do(ctx, req))
+	if err != nil {
+		return nil, err
+	}
+
+	if res.StatusCode == 404 {
+		return nil, nil
+	}
+
+	var roleScopes []OrganizationScopeModel
+	if err := decode(res.Body, &roleScopes); err != nil {
+		return nil, err
+	}
+	return roleScopes, nil
+}
+
+func (c *Client) OrganizationRoleScopesUpdate(ctx context.Context, roleId string, scopeIds *OrganizationScopeIdsModel) error {
+	if roleId == "" {
+		return errEmptyID
+	}
+
+	req := &request{
+		method: http.MethodPut,
+		path:   path.Join("api/organization-roles", roleId, "scopes"),
+		body:   scopeIds,
+	}
+
+	_, err := expect(204)(c.do(ctx, req))
+	return err
+}
+
+func (c *Client) OrganizationRoleResourceScopesGet(ctx context.Context, roleId string) ([]ScopeModel, error) {
+	if roleId == "" {
+		return nil, errEmptyID
+	}
+
+	req := &request{
+		method: http.MethodGet,
+		path:   path.Join("api/organization-roles", roleId, "resource-scopes"),
+	}
+
+	res, err := expect(200, 404)(c.do(ctx, req))
+	if err != nil {
+		return nil, err
+	}
+
+	if res.StatusCode == 404 {
+		return nil, nil
+	}
+
+	var roleScopes []ScopeModel
+	if err := decode(res.Body, &roleScopes); err != nil {
+		return nil, err
+	}
+	return roleScopes, nil
+}
+
+func (c *Client) OrganizationRoleResourceScopesUpdate(ctx context.Context, roleId string, scopeIds *ScopeIdsModel) error {
+	if roleId == "" {
+		return errEmptyID
+	}
+
+	req := &request{
+		method: http.MethodPut,
+		path:   path.Join("api/organization-roles", roleId, "resource-scopes"),
+		body:   scopeIds,
+	}
+
+	_, err := expect(204)(c.do(ctx, req))
+	return err
+}
@@ -0,0 +1,112 @@
+package client
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestOrganizationRole(t *testing.T) {
+	ctx := context.Background()
+	config := newTestConfig(t)
+	client, err := NewClient(config)
+	require.NoError(t, err)
+
+	role, err := client.OrganizationRoleGet(ctx, "not-found")
+	require.NoError(t, err)
+	require.Nil(t, role)
+
+	organizationScope, err := client.OrganizationScopeCreate(
+		ctx,
+		&OrganizationScopeModel{Name: "read:test_organization_role"},
+	)
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, client.OrganizationScopeDelete(ctx, organizationScope.ID))
+	}()
+
+	apiResource, err := client.ApiResourceCreate(
+		ctx,
+		&ApiResourceModel{
+			Name:      "test_organization_role_api_resource",
+			Indicator: "https://organization-role.test",
+		},
+	)
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, client.ApiResourceDelete(ctx, apiResource.ID))
+	}()
+
+	resourceScope, err := client.ApiResourceScopeCreate(
+		ctx,
+		apiResource.ID,
+		&ScopeModel{Name: "read:test_organization_role"},
+	)
+	require.NoError(t, err)
+
+	role, err = client.OrganizationRoleCreate(
+		ctx,
+		&OrganizationRoleModel{
+			Name:                 "test_organization_role",
+			Description:          "An organization role to test the Terraform provider.",
+			OrganizationScopeIds: []string{organizationScope.ID},
+		},
+	)
+	require.NoError(t, err)
+	require.NotEmpty(t, role.ID)
+	require.NotEmpty(t, role.TenantId)
+	require.NotEmpty(t, role.Type)
+	require.Equal(t, "test_organization_role", role.Name)
+	require.Equal(t, "An organization role to test the Terraform provider.", role.Description)
+
+	roleId := role.ID
+
+	role, err = client.OrganizationRoleGet(ctx, roleId)
+	require.NoError(t, err)
+	require.NotNil(t, role)
+	require.Equal(t, "test_organization_role", role.Name)
+
+	roles, err := client.OrganizationRoleList(ctx, nil)
+	require.NoError(t, err)
+	require.NotEmpty(t, roles)
+
+	roleScopes, err := client.OrganizationRoleScopesGet(ctx, roleId)
+	require.NoError(t, err)
+	require.Len(t, roleScopes, 1)
+	require.Equal(t, organizationScope.ID, roleScopes[0].ID)
+
+	resourceScopes, err := client.OrganizationRoleResourceScopesGet(ctx, roleId)
+	require.NoError(t, err)
+	require.Empty(t, resourceScopes)
+
+	err = client.OrganizationRoleResourceScopesUpdate(ctx, roleId, &ScopeIdsModel{ScopeIds: []string{resourceScope.ID}})
+	require.NoError(t, err)
+
+	resourceScopes, err = client.OrganizationRoleResourceScopesGet(ctx, roleId)
+	require.NoError(t, err)
+	require.Len(t, resourceScopes, 1)
+	require.Equal(t, resourceScope.ID, resourceScopes[0].ID)
+	require.Equal(t, apiResource.ID, resourceScopes[0].ResourceId)
+
+	err = client.OrganizationRoleScopesUpdate(ctx, roleId, &OrganizationScopeIdsModel{OrganizationScopeIds: []string{}})
+	require.NoError(t, err)
+
+	roleScopes, err = client.OrganizationRoleScopesGet(ctx, roleId)
+	require.NoError(t, err)
+	require.Empty(t, roleScopes)
+
+	role.Name = "test_organization_role_update"
+	role, err = client.OrganizationRoleUpdate(ctx, role)
+	require.NoError(t, err)
+	require.NotNil(t, role)
+	require.Equal(t, roleId, role.ID)
+	require.Equal(t, "test_organization_role_update", role.Name)
+
+	err = client.OrganizationRoleDelete(ctx, roleId)
+	require.NoError(t, err)
+
+	role, err = client.OrganizationRoleGet(ctx, roleId)
+	require.NoError(t, err)
+	require.Nil(t, role)
+}
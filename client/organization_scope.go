@@ -0,0 +1,108 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"path"
+)
+
+func (c *Client) OrganizationScopeGet(ctx context.Context, id string) (*OrganizationScopeModel, error) {
+	if id == "" {
+		return nil, errEmptyID
+	}
+
+	req := &request{
+		method: http.MethodGet,
+		path:   path.Join("api/organization-scopes", id),
+	}
+
+	res, err := expect(200, 404)(c.do(ctx, req))
+	if err != nil {
+		return nil, err
+	}
+
+	if res.StatusCode == 404 {
+		return nil, nil
+	}
+
+	var scope OrganizationScopeModel
+	if err := decode(res.Body, &scope); err != nil {
+		return nil, err
+	}
+	return &scope, nil
+}
+
+func (c *Client) OrganizationScopeList(ctx context.Context, query_params map[string]string) ([]OrganizationScopeModel, error) {
+	req := &request{
+		method:          http.MethodGet,
+		path:            "api/organization-scopes",
+		queryParameters: query_params,
+	}
+
+	res, err := expect(200)(c.do(ctx, req))
+	if err != nil {
+		return nil, err
+	}
+
+	var scopes []OrganizationScopeModel
+	if err := decode(res.Body, &scopes); err != nil {
+		return nil, err
+	}
+	return scopes, nil
+}
+
+func (c *Client) OrganizationScopeCreate(ctx context.Context, scope *OrganizationScopeModel) (*OrganizationScopeModel, error) {
+	req := &request{
+		method: http.MethodPost,
+		path:   "api/organization-scopes",
+		body:   scope,
+	}
+
+	res, err := expect(201)(c.do(ctx, req))
+	if err != nil {
+		return nil, err
+	}
+
+	var returnScope OrganizationScopeModel
+	if err := decode(res.Body, &returnScope); err != nil {
+		return nil, err
+	}
+	return &returnScope, nil
+}
+
+func (c *Client) OrganizationScopeDelete(ctx context.Context, id string) error {
+	if id == "" {
+		return errEmptyID
+	}
+
+	req := &request{
+		method: http.MethodDelete,
+		path:   path.Join("api/organization-scopes", id),
+	}
+
+	_, err := expect(204)(c.do(ctx, req))
+	return err
+}
+
+func (c *Client) OrganizationScopeUpdate(ctx context.Context, scope *OrganizationScopeModel) (*OrganizationScopeModel, error) {
+	if scope.ID == "" {
+		return nil, errEmptyID
+	}
+
+	req := &request{
+		method: http.MethodPatch,
+		path:   path.Join("api/organization-scopes", scope.ID),
+		body:   scope,
+	}
+
+	res, err := expect(200)(c.do(ctx, req))
+	if err != nil {
+		return nil, err
+	}
+
+	var returnScope OrganizationScopeModel
+	if err := decode(res.Body, &returnScope); err != nil {
+		return nil, err
+	}
+	return &returnScope, nil
+}
@@ -0,0 +1,57 @@
+package client
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestOrganizationScope(t *testing.T) {
+	ctx := context.Background()
+	config := newTestConfig(t)
+	client, err := NewClient(config)
+	require.NoError(t, err)
+
+	scope, err := client.OrganizationScopeGet(ctx, "not-found")
+	require.NoError(t, err)
+	require.Nil(t, scope)
+
+	scope, err = client.OrganizationScopeCreate(
+		ctx,
+		&OrganizationScopeModel{
+			Name:        "read:test_organization_scope",
+			Description: "An organization scope to test the Terraform provider.",
+		},
+	)
+	require.NoError(t, err)
+	require.NotEmpty(t, scope.ID)
+	require.NotEmpty(t, scope.TenantId)
+	require.Equal(t, "read:test_organization_scope", scope.Name)
+	require.Equal(t, "An organization scope to test the Terraform provider.", scope.Description)
+
+	scopeId := scope.ID
+
+	scope, err = client.OrganizationScopeGet(ctx, scopeId)
+	require.NoError(t, err)
+	require.NotNil(t, scope)
+	require.Equal(t, "read:test_organization_scope", scope.Name)
+
+	scopes, err := client.OrganizationScopeList(ctx, nil)
+	require.NoError(t, err)
+	require.NotEmpty(t, scopes)
+
+	scope.Name = "write:test_organization_scope"
+	scope, err = client.OrganizationScopeUpdate(ctx, scope)
+	require.NoError(t, err)
+	require.NotNil(t, scope)
+	require.Equal(t, scopeId, scope.ID)
+	require.Equal(t, "write:test_organization_scope", scope.Name)
+
+	err = client.OrganizationScopeDelete(ctx, scopeId)
+	require.NoError(t, err)
+
+	scope, err = client.OrganizationScopeGet(ctx, scopeId)
+	require.NoError(t, err)
+	require.Nil(t, scope)
+}
@@ -0,0 +1,72 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestOrganization(t *testing.T) {
+	ctx := context.Background()
+	config := newTestConfig(t)
+	client, err := NewClient(config)
+	require.NoError(t, err)
+
+	organization, err := client.OrganizationGet(ctx, "not-found")
+	require.NoError(t, err)
+	require.Nil(t, organization)
+
+	organization, err = client.OrganizationCreate(
+		ctx,
+		&OrganizationModel{
+			Name:          "test_organization",
+			Description:   "An organization to test the Terraform provider.",
+			CustomData:    json.RawMessage(`{"plan":"free"}`),
+			IsMfaRequired: true,
+			Branding: &OrganizationBranding{
+				LogoUrl: "https://logo.test/logo.png",
+			},
+		},
+	)
+	require.NoError(t, err)
+	require.NotEmpty(t, organization.ID)
+	require.NotEmpty(t, organization.TenantId)
+	require.NotNil(t, organization.CreatedAt)
+	require.Equal(t, "test_organization", organization.Name)
+	require.Equal(t, "An organization to test the Terraform provider.", organization.Description)
+	require.JSONEq(t, `{"plan":"free"}`, string(organization.CustomData))
+	require.True(t, organization.IsMfaRequired)
+	require.NotNil(t, organization.Branding)
+	require.Equal(t, "https://logo.test/logo.png", organization.Branding.LogoUrl)
+
+	organizationId := organization.ID
+
+	organization, err = client.OrganizationGet(ctx, organizationId)
+	require.NoError(t, err)
+	require.NotNil(t, organization)
+	require.Equal(t, "test_organization", organization.Name)
+
+	organizations, err := client.OrganizationList(ctx, nil)
+	require.NoError(t, err)
+	require.NotEmpty(t, organizations)
+
+	organization.Name = "test_organization_update"
+	organization.CustomData = json.RawMessage(`{}`)
+	organization.IsMfaRequired = false
+	organization, err = client.OrganizationUpdate(ctx, organization)
+	require.NoError(t, err)
+	require.NotNil(t, organization)
+	require.Equal(t, organizationId, organization.ID)
+	require.Equal(t, "test_organization_update", organization.Name)
+	require.JSONEq(t, `{}`, string(organization.CustomData))
+	require.False(t, organization.IsMfaRequired)
+
+	err = client.OrganizationDelete(ctx, organizationId)
+	require.NoError(t, err)
+
+	organization, err = client.OrganizationGet(ctx, organizationId)
+	require.NoError(t, err)
+	require.Nil(t, organization)
+}
@@ -0,0 +1,105 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"path"
+)
+
+func (c *Client) OrganizationUsersAdd(ctx context.Context, organizationId string, userIds *UserIdsModel) error {
+	if organizationId == "" || len(userIds.UserIds) == 0 {
+		return errEmptyID
+	}
+
+	req := &request{
+		method: http.MethodPost,
+		path:   path.Join("api/organizations", organizationId, "users"),
+		body:   userIds,
+	}
+
+	_, err := expect(201)(c.do(ctx, req))
+	return err
+}
+
+func (c *Client) OrganizationUserRemove(ctx context.Context, organizationId string, userId string) error {
+	if organizationId == "" || userId == "" {
+		return errEmptyID
+	}
+
+	req := &request{
+		method: http.MethodDelete,
+		path:   path.Join("api/organizations", organizationId, "users", userId),
+	}
+
+	_, err := expect(204)(c.do(ctx, req))
+	return err
+}
+
+func (c *Client) OrganizationUserRolesGet(ctx context.Context, organizationId string, userId string) ([]OrganizationRoleModel, error) {
+	if organizationId == "" || userId == "" {
+		return nil, errEmptyID
+	}
+
+	req := &request{
+		method: http.MethodGet,
+		path:   path.Join("api/organizations", organizationId, "users", userId, "roles"),
+	}
+
+	res, err := expect(200, 404)(c.do(ctx, req))
+	if err != nil {
+		return nil, err
+	}
+
+	if res.StatusCode == 404 {
+		return nil, nil
+	}
+
+	var roles []OrganizationRoleModel
+	if err := decode(res.Body, &roles); err != nil {
+		return nil, err
+	}
+	return roles, nil
+}
+
+func (c *Client) OrganizationUserRolesUpdate(ctx context.Context, organizationId string, userId string, roleIds *OrganizationRoleIdsModel) error {
+	if organizationId == "" || userId == "" {
+		return errEmptyID
+	}
+
+	req := &request{
+		method: http.MethodPut,
+		path:   path.Join("api/organizations", organizationId, "users", userId, "roles"),
+		body:   roleIds,
+	}
+
+	_, err := expect(204)(c.do(ctx, req))
+	return err
+}
+
+// UserOrganizationsGet returns the organizations the user is a member of,
+// along with the organization roles the user has in each of them.
+func (c *Client) UserOrganizationsGet(ctx context.Context, userId string) ([]UserOrganizationModel, error) {
+	if userId == "" {
+		return nil, errEmptyID
+	}
+
+	req := &request{
+		method: http.MethodGet,
+		path:   path.Join("api/users", userId, "organizations"),
+	}
+
+	res, err := expect(200, 404)(c.do(ctx, req))
+	if err != nil {
+		return nil, err
+	}
+
+	if res.StatusCode == 404 {
+		return nil, nil
+	}
+
+	var organizations []UserOrganizationModel
+	if err := decode(res.Body, &organizations); err != nil {
+		return nil, err
+	}
+	return organizations, nil
+}
@@ -0,0 +1,67 @@
+package client
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestOrganizationUser(t *testing.T) {
+	ctx := context.Background()
+	config := newTestConfig(t)
+	client, err := NewClient(config)
+	require.NoError(t, err)
+
+	organization, err := client.OrganizationCreate(ctx, &OrganizationModel{Name: "test_organization_user"})
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, client.OrganizationDelete(ctx, organization.ID))
+	}()
+
+	role, err := client.OrganizationRoleCreate(ctx, &OrganizationRoleModel{Name: "test_organization_user_role"})
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, client.OrganizationRoleDelete(ctx, role.ID))
+	}()
+
+	user, err := client.UserCreate(ctx, &UserModel{Username: "test_organization_user"})
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, client.UserDelete(ctx, user.ID))
+	}()
+
+	roles, err := client.OrganizationUserRolesGet(ctx, organization.ID, user.ID)
+	require.NoError(t, err)
+	require.Nil(t, roles)
+
+	err = client.OrganizationUsersAdd(ctx, organization.ID, &UserIdsModel{UserIds: []string{user.ID}})
+	require.NoError(t, err)
+
+	roles, err = client.OrganizationUserRolesGet(ctx, organization.ID, user.ID)
+	require.NoError(t, err)
+	require.NotNil(t, roles)
+	require.Empty(t, roles)
+
+	err = client.OrganizationUserRolesUpdate(ctx, organization.ID, user.ID, &OrganizationRoleIdsModel{OrganizationRoleIds: []string{role.ID}})
+	require.NoError(t, err)
+
+	roles, err = client.OrganizationUserRolesGet(ctx, organization.ID, user.ID)
+	require.NoError(t, err)
+	require.Len(t, roles, 1)
+	require.Equal(t, role.ID, roles[0].ID)
+
+	organizations, err := client.UserOrganizationsGet(ctx, user.ID)
+	require.NoError(t, err)
+	require.Len(t, organizations, 1)
+	require.Equal(t, organization.ID, organizations[0].ID)
+	require.Len(t, organizations[0].OrganizationRoles, 1)
+	require.Equal(t, role.ID, organizations[0].OrganizationRoles[0].ID)
+
+	err = client.OrganizationUserRemove(ctx, organization.ID, user.ID)
+	require.NoError(t, err)
+
+	organizations, err = client.UserOrganizationsGet(ctx, user.ID)
+	require.NoError(t, err)
+	require.Empty(t, organizations)
+}
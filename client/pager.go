@@ -0,0 +1,113 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"iter"
+	"net/http"
+	"path"
+	"strconv"
+)
+
+// defaultPageSize is the largest page size accepted by Logto.
+const defaultPageSize = 100
+
+// all returns an iterator over every item of the list endpoint. Pages
+// are fetched lazily using the page and page_size query parameters until the
+// number of items given by the Total-Number header has been reached. The
+// page_size can be overridden through queryParameters.
+//
+// The iteration stops at the first error, which is yielded with the zero
+// value of T.
+func all[T any](ctx context.Context, c *Client, endpoint string, queryParameters map[string]string) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		var zero T
+
+		params := map[string]string{
+			"page_size": strconv.Itoa(defaultPageSize),
+		}
+		for key, value := range queryParameters {
+			params[key] = value
+		}
+
+		seen := 0
+		for page := 1; ; page++ {
+			params["page"] = strconv.Itoa(page)
+			req := &request{
+				method:          http.MethodGet,
+				path:            endpoint,
+				queryParameters: params,
+			}
+
+			res, err := expect(200)(c.do(ctx, req))
+			if err != nil {
+				yield(zero, err)
+				return
+			}
+
+			var items []T
+			if err := decode(res.Body, &items); err != nil {
+				yield(zero, err)
+				return
+			}
+
+			for _, item := range items {
+				if !yield(item, nil) {
+					return
+				}
+			}
+			seen += len(items)
+
+			// Endpoints that do not support pagination do not send the
+			// header and return everything at once.
+			header := res.Header.Get("Total-Number")
+			if header == "" || len(items) == 0 {
+				return
+			}
+			total, err := strconv.Atoi(header)
+			if err != nil {
+				yield(zero, fmt.Errorf("invalid Total-Number header %q: %w", header, err))
+				return
+			}
+			if seen >= total {
+				return
+			}
+		}
+	}
+}
+
+func (c *Client) ApplicationsAll(ctx context.Context, query_params map[string]string) iter.Seq2[ApplicationModel, error] {
+	return all[ApplicationModel](ctx, c, "api/applications", query_params)
+}
+
+func (c *Client) UsersAll(ctx context.Context, query_params map[string]string) iter.Seq2[UserModel, error] {
+	return all[UserModel](ctx, c, "api/users", query_params)
+}
+
+func (c *Client) RolesAll(ctx context.Context, query_params map[string]string) iter.Seq2[RoleModel, error] {
+	return all[RoleModel](ctx, c, "api/roles", query_params)
+}
+
+func (c *Client) ApiResourcesAll(ctx context.Context, query_params map[string]string) iter.Seq2[ApiResourceModel, error] {
+	return all[ApiResourceModel](ctx, c, "api/resources", query_params)
+}
+
+func (c *Client) ApiResourceScopesAll(ctx context.Context, resourceId string, query_params map[string]string) iter.Seq2[ScopeModel, error] {
+	return all[ScopeModel](ctx, c, path.Join("api/resources", resourceId, "scopes"), query_params)
+}
+
+func (c *Client) UserRolesAll(ctx context.Context, userId string, query_params map[string]string) iter.Seq2[RoleModel, error] {
+	return all[RoleModel](ctx, c, path.Join("api/users", userId, "roles"), query_params)
+}
+
+func (c *Client) RoleUsersAll(ctx context.Context, roleId string, query_params map[string]string) iter.Seq2[UserModel, error] {
+	return all[UserModel](ctx, c, path.Join("api/roles", roleId, "users"), query_params)
+}
+
+func (c *Client) RoleApplicationsAll(ctx context.Context, roleId string, query_params map[string]string) iter.Seq2[ApplicationModel, error] {
+	return all[ApplicationModel](ctx, c, path.Join("api/roles", roleId, "applications"), query_params)
+}
+
+func (c *Client) RoleScopesAll(ctx context.Context, roleId string, query_params map[string]string) iter.Seq2[ScopeModel, error] {
+	return all[ScopeModel](ctx, c, path.Join("api/roles", roleId, "scopes"), query_params)
+}
@@ -0,0 +1,65 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestPager(t *testing.T) {
+	ctx := context.Background()
+	config := newTestConfig(t)
+	client, err := NewClient(config)
+	require.NoError(t, err)
+
+	apiResource, err := client.ApiResourceCreate(ctx, &ApiResourceModel{
+		Name:      "test_pager",
+		Indicator: "https://pager.test",
+	})
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, client.ApiResourceDelete(ctx, apiResource.ID))
+	}()
+
+	expected := []string{}
+	for i := range 5 {
+		scope, err := client.ApiResourceScopeCreate(ctx, apiResource.ID, &ScopeModel{
+			Name: fmt.Sprintf("test_pager_%d", i),
+		})
+		require.NoError(t, err)
+		expected = append(expected, scope.ID)
+	}
+
+	// A page size of 2 forces the pager to fetch three pages
+	var ids []string
+	for scope, err := range client.ApiResourceScopesAll(ctx, apiResource.ID, map[string]string{"page_size": "2"}) {
+		require.NoError(t, err)
+		ids = append(ids, scope.ID)
+	}
+	require.ElementsMatch(t, expected, ids)
+
+	// Breaking out of the loop stops the iteration
+	count := 0
+	for _, err := range client.ApiResourceScopesAll(ctx, apiResource.ID, map[string]string{"page_size": "2"}) {
+		require.NoError(t, err)
+		count++
+		if count == 3 {
+			break
+		}
+	}
+	require.Equal(t, 3, count)
+
+	// Errors are yielded
+	for _, err := range client.ApiResourceScopesAll(ctx, "not-found", nil) {
+		require.Error(t, err)
+	}
+
+	found := false
+	for resource, err := range client.ApiResourcesAll(ctx, nil) {
+		require.NoError(t, err)
+		found = found || resource.ID == apiResource.ID
+	}
+	require.True(t, found)
+}
@@ -0,0 +1,61 @@
+package client
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestProtectedApp(t *testing.T) {
+	ctx := context.Background()
+	config := newTestConfig(t)
+	client, err := NewClient(config)
+	require.NoError(t, err)
+
+	app, err := client.ApplicationCreate(ctx, &ApplicationModel{
+		Name: "test",
+		Type: "Protected",
+		ProtectedAppMetadata: &ProtectedAppMetadata{
+			SubDomain: "terraform-provider-test",
+			Origin:    "https://example.com",
+		},
+	})
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		require.NoError(t, client.ApplicationDelete(ctx, app.ID))
+	})
+
+	require.NotNil(t, app.ProtectedAppMetadata)
+	require.NotEmpty(t, app.ProtectedAppMetadata.Host)
+	require.Equal(t, "https://example.com", app.ProtectedAppMetadata.Origin)
+	require.NotZero(t, app.ProtectedAppMetadata.SessionDuration)
+
+	app.ProtectedAppMetadata = &ProtectedAppMetadata{
+		Origin:          "https://example.org",
+		SessionDuration: 3600,
+		PageRules:       &[]PageRule{{Path: "^/admin"}},
+	}
+	app, err = client.ApplicationUpdate(ctx, app)
+	require.NoError(t, err)
+	require.Equal(t, "https://example.org", app.ProtectedAppMetadata.Origin)
+	require.Equal(t, float64(3600), app.ProtectedAppMetadata.SessionDuration)
+	require.Equal(t, &[]PageRule{{Path: "^/admin"}}, app.ProtectedAppMetadata.PageRules)
+
+	err = client.ApplicationCustomDomainAdd(ctx, app.ID, "auth.example.com")
+	require.NoError(t, err)
+	err = client.ApplicationCustomDomainAdd(ctx, app.ID, "auth.example.com")
+	require.Error(t, err)
+
+	app, err = client.ApplicationGet(ctx, app.ID)
+	require.NoError(t, err)
+	require.Len(t, app.ProtectedAppMetadata.CustomDomains, 1)
+	require.Equal(t, "auth.example.com", app.ProtectedAppMetadata.CustomDomains[0].Domain)
+
+	err = client.ApplicationCustomDomainRemove(ctx, app.ID, "auth.example.com")
+	require.NoError(t, err)
+
+	app, err = client.ApplicationGet(ctx, app.ID)
+	require.NoError(t, err)
+	require.Empty(t, app.ProtectedAppMetadata.CustomDomains)
+}
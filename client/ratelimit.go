@@ -0,0 +1,119 @@
+package client
+
+import (
+	"context"
+	"math"
+	"sync"
+	"time"
+)
+
+// RateLimit bounds the load the client puts on Logto. As a single client is
+// shared by all the resources of the provider, it applies to the whole plan.
+type RateLimit struct {
+	// RequestsPerSecond is the rate at which the token bucket refills. A
+	// value of 0 disables the limiter.
+	RequestsPerSecond float64
+
+	// Burst is the number of requests that can be sent at once when the
+	// bucket is full. It defaults to RequestsPerSecond rounded up.
+	Burst int
+
+	// MaxInFlight caps the number of requests waiting for a response. A
+	// value of 0 means no limit.
+	MaxInFlight int
+}
+
+// limiter enforces a RateLimit, every attempt sent to Logto must call
+// acquire first and the returned release function once it got a response.
+type limiter struct {
+	rate  float64
+	burst float64
+
+	lock   sync.Mutex
+	tokens float64
+	last   time.Time
+
+	inFlight chan struct{}
+}
+
+func newLimiter(conf *RateLimit) *limiter {
+	l := &limiter{}
+	if conf == nil {
+		return l
+	}
+
+	if conf.RequestsPerSecond > 0 {
+		l.rate = conf.RequestsPerSecond
+		l.burst = float64(conf.Burst)
+		if l.burst < 1 {
+			l.burst = max(math.Ceil(l.rate), 1)
+		}
+		l.tokens = l.burst
+		l.last = time.Now()
+	}
+	if conf.MaxInFlight > 0 {
+		l.inFlight = make(chan struct{}, conf.MaxInFlight)
+	}
+	return l
+}
+
+// acquire blocks until the request can be sent and returns how long it
+// waited for it, which is 0 when it was not delayed.
+func (l *limiter) acquire(ctx context.Context) (release func(), waited time.Duration, err error) {
+	start := time.Now()
+	elapsed := func() time.Duration {
+		if waited := time.Since(start); waited > time.Millisecond {
+			return waited
+		}
+		return 0
+	}
+
+	if d := l.reserve(); d > 0 {
+		if err := sleep(ctx, d); err != nil {
+			l.cancel()
+			return nil, elapsed(), err
+		}
+	}
+
+	release = func() {}
+	if l.inFlight != nil {
+		select {
+		case l.inFlight <- struct{}{}:
+		case <-ctx.Done():
+			return nil, elapsed(), ctx.Err()
+		}
+		release = func() { <-l.inFlight }
+	}
+
+	return release, elapsed(), nil
+}
+
+// reserve takes a token from the bucket and returns how long to wait before
+// it becomes available. The bucket can go negative so that the waiting
+// requests are served in turn.
+func (l *limiter) reserve() time.Duration {
+	if l.rate == 0 {
+		return 0
+	}
+
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	now := time.Now()
+	l.tokens = min(l.burst, l.tokens+now.Sub(l.last).Seconds()*l.rate)
+	l.last = now
+
+	l.tokens--
+	if l.tokens >= 0 {
+		return 0
+	}
+	return time.Duration(-l.tokens / l.rate * float64(time.Second))
+}
+
+// cancel gives back a token that was reserved but not used.
+func (l *limiter) cancel() {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	l.tokens = min(l.burst, l.tokens+1)
+}
@@ -0,0 +1,91 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRateLimit(t *testing.T) {
+	ctx := context.Background()
+
+	// No limit by default
+	l := newLimiter(nil)
+	for range 100 {
+		release, waited, err := l.acquire(ctx)
+		require.NoError(t, err)
+		require.Zero(t, waited)
+		release()
+	}
+
+	// The burst is served at once, then requests are spaced
+	l = newLimiter(&RateLimit{RequestsPerSecond: 20, Burst: 2})
+	start := time.Now()
+	for range 6 {
+		release, _, err := l.acquire(ctx)
+		require.NoError(t, err)
+		release()
+	}
+	require.GreaterOrEqual(t, time.Since(start), 190*time.Millisecond)
+
+	// A canceled wait gives its token back
+	l = newLimiter(&RateLimit{RequestsPerSecond: 1})
+	_, _, err := l.acquire(ctx)
+	require.NoError(t, err)
+	canceled, cancel := context.WithTimeout(ctx, 10*time.Millisecond)
+	defer cancel()
+	_, waited, err := l.acquire(canceled)
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+	require.NotZero(t, waited)
+	require.InDelta(t, 0, l.tokens, 0.1)
+}
+
+func TestMaxInFlight(t *testing.T) {
+	var current, highest atomic.Int32
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/oidc/token" {
+			_, _ = w.Write([]byte(`{"access_token":"token","expires_in":3600,"token_type":"Bearer"}`))
+			return
+		}
+
+		n := current.Add(1)
+		defer current.Add(-1)
+		for {
+			h := highest.Load()
+			if n <= h || highest.CompareAndSwap(h, n) {
+				break
+			}
+		}
+		time.Sleep(20 * time.Millisecond)
+		_, _ = w.Write([]byte(`{"id":"id"}`))
+	}))
+	defer server.Close()
+
+	client, err := NewClient(&Config{
+		Hostname:          server.Listener.Addr().String(),
+		ApplicationID:     "id",
+		ApplicationSecret: "secret",
+		HttpClient:        server.Client(),
+		RateLimit:         &RateLimit{MaxInFlight: 2},
+	})
+	require.NoError(t, err)
+
+	var wg sync.WaitGroup
+	for range 10 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := client.RoleGet(context.Background(), "id")
+			require.NoError(t, err)
+		}()
+	}
+	wg.Wait()
+
+	require.EqualValues(t, 2, highest.Load())
+}
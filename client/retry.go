@@ -0,0 +1,130 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"math/rand/v2"
+	"net/http"
+	"slices"
+	"strconv"
+	"time"
+)
+
+// RetryPolicy controls how the client retries the requests that failed
+// because of a transient error.
+type RetryPolicy struct {
+	// MaxAttempts is the maximum number of times a request is sent, including
+	// the first attempt. A value of 1 disables retries.
+	MaxAttempts int
+
+	// BaseDelay is the delay before the first retry, it doubles after each
+	// attempt up to MaxDelay.
+	BaseDelay time.Duration
+
+	// MaxDelay caps both the exponential backoff and the delay asked by the
+	// server through the Retry-After header.
+	MaxDelay time.Duration
+
+	// Jitter is the fraction, between 0 and 1, of the delay that is
+	// randomized so that concurrent clients do not retry in lockstep.
+	Jitter float64
+
+	// RetryableStatuses are the HTTP status codes that trigger a retry.
+	RetryableStatuses []int
+
+	// RetryNonIdempotent allows retrying POST and PATCH requests. Those are
+	// not retried by default as the first attempt may have been applied by
+	// Logto even though it failed.
+	RetryNonIdempotent bool
+}
+
+func DefaultRetryPolicy() *RetryPolicy {
+	return &RetryPolicy{
+		MaxAttempts: 4,
+		BaseDelay:   500 * time.Millisecond,
+		MaxDelay:    30 * time.Second,
+		Jitter:      0.2,
+		RetryableStatuses: []int{
+			http.StatusTooManyRequests,
+			http.StatusBadGateway,
+			http.StatusServiceUnavailable,
+			http.StatusGatewayTimeout,
+		},
+	}
+}
+
+// shouldRetry tells whether the outcome of the given attempt can be retried.
+func (p *RetryPolicy) shouldRetry(r *request, attempt int, res *http.Response, err error) bool {
+	if attempt >= p.MaxAttempts {
+		return false
+	}
+
+	// The raw body has already been consumed by the first attempt.
+	if r.rawBody != nil {
+		return false
+	}
+
+	if !p.RetryNonIdempotent && !isIdempotent(r.method) {
+		return false
+	}
+
+	if err != nil {
+		return !errors.Is(err, context.Canceled) && !errors.Is(err, context.DeadlineExceeded)
+	}
+	return slices.Contains(p.RetryableStatuses, res.StatusCode)
+}
+
+// delay returns how long to wait before sending the next attempt. The
+// Retry-After header takes precedence over the exponential backoff.
+func (p *RetryPolicy) delay(attempt int, res *http.Response) time.Duration {
+	if res != nil {
+		if d, ok := retryAfter(res.Header.Get("Retry-After")); ok {
+			return min(d, p.MaxDelay)
+		}
+	}
+
+	d := p.BaseDelay << (attempt - 1)
+	if d <= 0 || d > p.MaxDelay {
+		d = p.MaxDelay
+	}
+	if p.Jitter > 0 {
+		d -= time.Duration(p.Jitter * rand.Float64() * float64(d))
+	}
+	return d
+}
+
+// retryAfter parses the Retry-After header, which is either a number of
+// seconds or an HTTP date.
+func retryAfter(header string) (time.Duration, bool) {
+	if header == "" {
+		return 0, false
+	}
+	if seconds, err := strconv.Atoi(header); err == nil && seconds >= 0 {
+		return time.Duration(seconds) * time.Second, true
+	}
+	if date, err := http.ParseTime(header); err == nil {
+		return max(time.Until(date), 0), true
+	}
+	return 0, false
+}
+
+func isIdempotent(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
+	default:
+		return false
+	}
+}
+
+func sleep(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
@@ -0,0 +1,150 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// newRetryTestClient returns a client talking to a server that answers the
+// calls to the Management API using the given statuses in turn, the last one
+// being repeated. It also returns the number of calls received by the API.
+func newRetryTestClient(t *testing.T, policy *RetryPolicy, header http.Header, statuses ...int) (*Client, *atomic.Int32) {
+	t.Helper()
+
+	calls := &atomic.Int32{}
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/oidc/token" {
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{"access_token":"token","expires_in":3600,"token_type":"Bearer"}`))
+			return
+		}
+		if !strings.HasPrefix(r.URL.Path, "/api/") {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		i := int(calls.Add(1)) - 1
+		status := statuses[min(i, len(statuses)-1)]
+		for key, values := range header {
+			w.Header()[key] = values
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(status)
+		_, _ = w.Write([]byte(`{"id":"id","name":"name"}`))
+	}))
+	t.Cleanup(server.Close)
+
+	client, err := NewClient(&Config{
+		Hostname:          server.Listener.Addr().String(),
+		ApplicationID:     "id",
+		ApplicationSecret: "secret",
+		HttpClient:        server.Client(),
+		Retry:             policy,
+	})
+	require.NoError(t, err)
+	return client, calls
+}
+
+func testRetryPolicy() *RetryPolicy {
+	policy := DefaultRetryPolicy()
+	policy.BaseDelay = time.Millisecond
+	policy.MaxDelay = 10 * time.Millisecond
+	return policy
+}
+
+func TestRetry(t *testing.T) {
+	ctx := context.Background()
+
+	// Transient errors are retried
+	client, calls := newRetryTestClient(t, testRetryPolicy(), nil, 503, 429, 200)
+	role, err := client.RoleGet(ctx, "id")
+	require.NoError(t, err)
+	require.Equal(t, "name", role.Name)
+	require.EqualValues(t, 3, calls.Load())
+
+	// The client gives up after MaxAttempts
+	client, calls = newRetryTestClient(t, testRetryPolicy(), nil, 502)
+	_, err = client.RoleGet(ctx, "id")
+	require.ErrorContains(t, err, "502")
+	require.EqualValues(t, 4, calls.Load())
+
+	// Other errors are not retried
+	client, calls = newRetryTestClient(t, testRetryPolicy(), nil, 500)
+	_, err = client.RoleGet(ctx, "id")
+	require.Error(t, err)
+	require.EqualValues(t, 1, calls.Load())
+
+	// Non-idempotent requests are only retried when asked to
+	client, calls = newRetryTestClient(t, testRetryPolicy(), nil, 503, 200)
+	_, err = client.RoleCreate(ctx, &RoleModel{Name: "name"})
+	require.Error(t, err)
+	require.EqualValues(t, 1, calls.Load())
+
+	policy := testRetryPolicy()
+	policy.RetryNonIdempotent = true
+	client, calls = newRetryTestClient(t, policy, nil, 503, 200)
+	_, err = client.RoleCreate(ctx, &RoleModel{Name: "name"})
+	require.NoError(t, err)
+	require.EqualValues(t, 2, calls.Load())
+
+	// A single attempt disables retries
+	policy = testRetryPolicy()
+	policy.MaxAttempts = 1
+	client, calls = newRetryTestClient(t, policy, nil, 503, 200)
+	_, err = client.RoleGet(ctx, "id")
+	require.Error(t, err)
+	require.EqualValues(t, 1, calls.Load())
+
+	// Retry-After is honored, up to MaxDelay
+	policy = testRetryPolicy()
+	policy.MaxDelay = 200 * time.Millisecond
+	client, calls = newRetryTestClient(t, policy, http.Header{"Retry-After": {"1"}}, 429, 200)
+	start := time.Now()
+	_, err = client.RoleGet(ctx, "id")
+	require.NoError(t, err)
+	require.EqualValues(t, 2, calls.Load())
+	require.GreaterOrEqual(t, time.Since(start), 200*time.Millisecond)
+	require.Less(t, time.Since(start), time.Second)
+
+	// Waiting stops when the context is canceled
+	policy = testRetryPolicy()
+	policy.BaseDelay = time.Minute
+	policy.MaxDelay = time.Minute
+	client, _ = newRetryTestClient(t, policy, nil, 503)
+	ctx, cancel := context.WithTimeout(ctx, 50*time.Millisecond)
+	defer cancel()
+	_, err = client.RoleGet(ctx, "id")
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+}
+
+func TestRetryDelay(t *testing.T) {
+	policy := &RetryPolicy{
+		BaseDelay: time.Second,
+		MaxDelay:  10 * time.Second,
+	}
+	require.Equal(t, time.Second, policy.delay(1, nil))
+	require.Equal(t, 2*time.Second, policy.delay(2, nil))
+	require.Equal(t, 8*time.Second, policy.delay(4, nil))
+	require.Equal(t, 10*time.Second, policy.delay(5, nil))
+	require.Equal(t, 10*time.Second, policy.delay(100, nil))
+
+	policy.Jitter = 0.5
+	for range 100 {
+		d := policy.delay(2, nil)
+		require.GreaterOrEqual(t, d, time.Second)
+		require.LessOrEqual(t, d, 2*time.Second)
+	}
+
+	res := &http.Response{Header: http.Header{"Retry-After": {"3"}}}
+	require.Equal(t, 3*time.Second, policy.delay(1, res))
+
+	res.Header.Set("Retry-After", time.Now().Add(time.Hour).UTC().Format(http.TimeFormat))
+	require.Equal(t, 10*time.Second, policy.delay(1, res))
+}
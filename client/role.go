@@ -32,6 +32,25 @@ func (c *Client) RoleGet(ctx context.Context, id string) (*RoleModel, error) {
 	return &role, nil
 }
 
+func (c *Client) RoleList(ctx context.Context, query_params map[string]string) ([]RoleModel, error) {
+	req := &request{
+		method:          http.MethodGet,
+		path:            "api/roles",
+		queryParameters: query_params,
+	}
+
+	res, err := expect(200)(c.do(ctx, req))
+	if err != nil {
+		return nil, err
+	}
+
+	var roles []RoleModel
+	if err := decode(res.Body, &roles); err != nil {
+		return nil, err
+	}
+	return roles, nil
+}
+
 func (c *Client) RoleScopesGet(ctx context.Context, roleId string) ([]ScopeModel, error) {
 	if roleId == "" {
 		return nil, errEmptyID
@@ -58,6 +77,37 @@ func (c *Client) RoleScopesGet(ctx context.Context, roleId string) ([]ScopeModel
 	return roleScopes, nil
 }
 
+// RoleScopesAdd assigns the scopes to the role. Logto rejects the scopes that
+// are already assigned to it.
+func (c *Client) RoleScopesAdd(ctx context.Context, roleId string, scopeIds *ScopeIdsModel) error {
+	if roleId == "" || len(scopeIds.ScopeIds) == 0 {
+		return errEmptyID
+	}
+
+	req := &request{
+		method: http.MethodPost,
+		path:   path.Join("api/roles", roleId, "scopes"),
+		body:   scopeIds,
+	}
+
+	_, err := expect(201)(c.do(ctx, req))
+	return err
+}
+
+func (c *Client) RoleScopeRemove(ctx context.Context, roleId string, scopeId string) error {
+	if roleId == "" || scopeId == "" {
+		return errEmptyID
+	}
+
+	req := &request{
+		method: http.MethodDelete,
+		path:   path.Join("api/roles", roleId, "scopes", scopeId),
+	}
+
+	_, err := expect(204)(c.do(ctx, req))
+	return err
+}
+
 func (c *Client) RoleCreate(ctx context.Context, role *RoleModel) (*RoleModel, error) {
 	req := &request{
 		method: http.MethodPost,
@@ -0,0 +1,65 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"path"
+)
+
+func (c *Client) RoleUsersAdd(ctx context.Context, roleId string, userIds *UserIdsModel) error {
+	if roleId == "" || len(userIds.UserIds) == 0 {
+		return errEmptyID
+	}
+
+	req := &request{
+		method: http.MethodPost,
+		path:   path.Join("api/roles", roleId, "users"),
+		body:   userIds,
+	}
+
+	_, err := expect(201)(c.do(ctx, req))
+	return err
+}
+
+func (c *Client) RoleUserRemove(ctx context.Context, roleId string, userId string) error {
+	if roleId == "" || userId == "" {
+		return errEmptyID
+	}
+
+	req := &request{
+		method: http.MethodDelete,
+		path:   path.Join("api/roles", roleId, "users", userId),
+	}
+
+	_, err := expect(204)(c.do(ctx, req))
+	return err
+}
+
+func (c *Client) RoleApplicationsAdd(ctx context.Context, roleId string, applicationIds *ApplicationIdsModel) error {
+	if roleId == "" || len(applicationIds.ApplicationIds) == 0 {
+		return errEmptyID
+	}
+
+	req := &request{
+		method: http.MethodPost,
+		path:   path.Join("api/roles", roleId, "applications"),
+		body:   applicationIds,
+	}
+
+	_, err := expect(201)(c.do(ctx, req))
+	return err
+}
+
+func (c *Client) RoleApplicationRemove(ctx context.Context, roleId string, applicationId string) error {
+	if roleId == "" || applicationId == "" {
+		return errEmptyID
+	}
+
+	req := &request{
+		method: http.MethodDelete,
+		path:   path.Join("api/roles", roleId, "applications", applicationId),
+	}
+
+	_, err := expect(204)(c.do(ctx, req))
+	return err
+}
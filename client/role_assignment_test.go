@@ -0,0 +1,92 @@
+package client
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRoleAssignment(t *testing.T) {
+	ctx := context.Background()
+	config := newTestConfig(t)
+	client, err := NewClient(config)
+	require.NoError(t, err)
+
+	user, err := client.UserCreate(ctx, &UserModel{
+		Username: "clientTestRoleAssignment",
+	})
+	require.NoError(t, err)
+	t.Cleanup(func() { require.NoError(t, client.UserDelete(ctx, user.ID)) })
+
+	app, err := client.ApplicationCreate(ctx, &ApplicationModel{
+		Name: "clientTestRoleAssignment",
+		Type: "MachineToMachine",
+	})
+	require.NoError(t, err)
+	t.Cleanup(func() { require.NoError(t, client.ApplicationDelete(ctx, app.ID)) })
+
+	userRole, err := client.RoleCreate(ctx, &RoleModel{
+		Name:        "clientTestRoleAssignmentUser",
+		Description: "A role to test the assignation from the role",
+	})
+	require.NoError(t, err)
+	t.Cleanup(func() { require.NoError(t, client.RoleDelete(ctx, userRole.ID)) })
+
+	appRole, err := client.RoleCreate(ctx, &RoleModel{
+		Name:        "clientTestRoleAssignmentApplication",
+		Description: "A role to test the assignation from the role",
+		Type:        "MachineToMachine",
+	})
+	require.NoError(t, err)
+	t.Cleanup(func() { require.NoError(t, client.RoleDelete(ctx, appRole.ID)) })
+
+	// Users
+	err = client.RoleUsersAdd(ctx, userRole.ID, &UserIdsModel{UserIds: []string{user.ID}})
+	require.NoError(t, err)
+
+	var userIds []string
+	for user, err := range client.RoleUsersAll(ctx, userRole.ID, nil) {
+		require.NoError(t, err)
+		userIds = append(userIds, user.ID)
+	}
+	require.Equal(t, []string{user.ID}, userIds)
+
+	roles, err := client.GetRolesForUser(ctx, user.ID)
+	require.NoError(t, err)
+	require.Len(t, roles, 1)
+	require.Equal(t, userRole.ID, roles[0].ID)
+
+	err = client.RoleUserRemove(ctx, userRole.ID, user.ID)
+	require.NoError(t, err)
+
+	for range client.RoleUsersAll(ctx, userRole.ID, nil) {
+		require.Fail(t, "the role should have no users")
+	}
+
+	// Machine-to-machine roles cannot be assigned to users
+	err = client.RoleUsersAdd(ctx, appRole.ID, &UserIdsModel{UserIds: []string{user.ID}})
+	require.Error(t, err)
+
+	// Applications
+	err = client.RoleApplicationsAdd(ctx, appRole.ID, &ApplicationIdsModel{ApplicationIds: []string{app.ID}})
+	require.NoError(t, err)
+
+	var appIds []string
+	for app, err := range client.RoleApplicationsAll(ctx, appRole.ID, nil) {
+		require.NoError(t, err)
+		appIds = append(appIds, app.ID)
+	}
+	require.Equal(t, []string{app.ID}, appIds)
+
+	err = client.RoleApplicationRemove(ctx, appRole.ID, app.ID)
+	require.NoError(t, err)
+
+	for range client.RoleApplicationsAll(ctx, appRole.ID, nil) {
+		require.Fail(t, "the role should have no applications")
+	}
+
+	// Removing a member that does not have the role fails
+	err = client.RoleApplicationRemove(ctx, appRole.ID, app.ID)
+	require.Error(t, err)
+}
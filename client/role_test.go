@@ -2,17 +2,14 @@ package client
 
 import (
 	"context"
-	"os"
 	"testing"
 
-	"github.com/rs/zerolog"
 	"github.com/stretchr/testify/require"
 )
 
 func TestRole(t *testing.T) {
 	ctx := context.Background()
-	config := DefaultConfig()
-	config.Logger = zerolog.New(os.Stdout)
+	config := newTestConfig(t)
 	client, err := NewClient(config)
 	require.NoError(t, err)
 
@@ -51,6 +48,10 @@ func TestRole(t *testing.T) {
 	require.Equal(t, expected.Name, role.Name)
 	require.Equal(t, expected.Description, role.Description)
 
+	roles, err := client.RoleList(ctx, nil)
+	require.NoError(t, err)
+	require.NotEmpty(t, roles)
+
 	role.Name = "test_role_update"
 	role, err = client.RoleUpdate(ctx, role)
 	require.NoError(t, err)
@@ -66,3 +67,55 @@ func TestRole(t *testing.T) {
 	err = client.RoleDelete(ctx, role.ID)
 	require.NoError(t, err)
 }
+
+func TestRoleScopes(t *testing.T) {
+	ctx := context.Background()
+	config := newTestConfig(t)
+	client, err := NewClient(config)
+	require.NoError(t, err)
+
+	apiResource, err := client.ApiResourceCreate(ctx, &ApiResourceModel{
+		Name:      "test_role_scopes",
+		Indicator: "https://role-scopes.test",
+	})
+	require.NoError(t, err)
+	t.Cleanup(func() { require.NoError(t, client.ApiResourceDelete(ctx, apiResource.ID)) })
+
+	read, err := client.ApiResourceScopeCreate(ctx, apiResource.ID, &ScopeModel{Name: "read"})
+	require.NoError(t, err)
+	write, err := client.ApiResourceScopeCreate(ctx, apiResource.ID, &ScopeModel{Name: "write"})
+	require.NoError(t, err)
+
+	role, err := client.RoleCreate(ctx, &RoleModel{
+		Name:        "test_role_scopes",
+		Description: "A role to test the management of its scopes.",
+		ScopeIds:    []string{read.ID},
+	})
+	require.NoError(t, err)
+	t.Cleanup(func() { require.NoError(t, client.RoleDelete(ctx, role.ID)) })
+
+	scopeIds := func() []string {
+		ids := []string{}
+		for scope, err := range client.RoleScopesAll(ctx, role.ID, nil) {
+			require.NoError(t, err)
+			ids = append(ids, scope.ID)
+		}
+		return ids
+	}
+	require.Equal(t, []string{read.ID}, scopeIds())
+
+	err = client.RoleScopesAdd(ctx, role.ID, &ScopeIdsModel{ScopeIds: []string{write.ID}})
+	require.NoError(t, err)
+	require.ElementsMatch(t, []string{read.ID, write.ID}, scopeIds())
+
+	// Scopes already assigned are rejected
+	err = client.RoleScopesAdd(ctx, role.ID, &ScopeIdsModel{ScopeIds: []string{write.ID}})
+	require.Error(t, err)
+
+	err = client.RoleScopeRemove(ctx, role.ID, read.ID)
+	require.NoError(t, err)
+	require.Equal(t, []string{write.ID}, scopeIds())
+
+	err = client.RoleScopeRemove(ctx, role.ID, read.ID)
+	require.Error(t, err)
+}
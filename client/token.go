@@ -0,0 +1,129 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// tokenRefreshMargin is how long before its expiry the access token is
+// renewed, so that it does not expire while a request is in flight. It is
+// capped to half of the lifetime of short-lived tokens.
+const tokenRefreshMargin = time.Minute
+
+// tokenSource caches the access token of the client and renews it when it
+// is about to expire, tokens without a known lifetime are kept until Logto
+// rejects them. Concurrent callers share a single refresh.
+type tokenSource struct {
+	fetch func(ctx context.Context) (string, time.Duration, error)
+
+	lock      sync.Mutex
+	token     string
+	refreshAt time.Time
+	inFlight  *tokenRefresh
+}
+
+// tokenRefresh is a request to the token endpoint the other callers can
+// wait on.
+type tokenRefresh struct {
+	done  chan struct{}
+	token string
+	err   error
+}
+
+// get returns a valid access token. When rejected is not empty it is a token
+// Logto refused, a new one is then fetched unless it was already replaced.
+func (s *tokenSource) get(ctx context.Context, rejected string) (string, error) {
+	for {
+		s.lock.Lock()
+		if s.token != "" {
+			valid := s.refreshAt.IsZero() || time.Now().Before(s.refreshAt)
+			if rejected != "" {
+				valid = s.token != rejected
+			}
+			if valid {
+				token := s.token
+				s.lock.Unlock()
+				return token, nil
+			}
+		}
+
+		refresh := s.inFlight
+		if refresh == nil {
+			refresh = &tokenRefresh{done: make(chan struct{})}
+			s.inFlight = refresh
+			s.lock.Unlock()
+
+			s.refresh(ctx, refresh)
+		} else {
+			s.lock.Unlock()
+		}
+
+		select {
+		case <-refresh.done:
+		case <-ctx.Done():
+			return "", ctx.Err()
+		}
+
+		// The refresh was made on behalf of a caller that gave up, try
+		// again with our own context.
+		if refresh.err != nil && ctx.Err() == nil &&
+			(errors.Is(refresh.err, context.Canceled) || errors.Is(refresh.err, context.DeadlineExceeded)) {
+			continue
+		}
+		return refresh.token, refresh.err
+	}
+}
+
+func (s *tokenSource) refresh(ctx context.Context, refresh *tokenRefresh) {
+	token, expiresIn, err := s.fetch(ctx)
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	s.inFlight = nil
+	refresh.token, refresh.err = token, err
+	close(refresh.done)
+
+	if err != nil {
+		s.token = ""
+		return
+	}
+	s.token = token
+	s.refreshAt = time.Time{}
+	if expiresIn > 0 {
+		s.refreshAt = time.Now().Add(expiresIn - min(tokenRefreshMargin, expiresIn/2))
+	}
+}
+
+// getAccessToken fetches a new access token using the authenticator of the
+// client.
+func (c *Client) getAccessToken(ctx context.Context) (string, time.Duration, error) {
+	endpoint := &TokenEndpoint{
+		URL:    fmt.Sprintf("https://%s/oidc/token", c.conf.Hostname),
+		client: c,
+	}
+	return c.conf.Authenticator.AccessToken(ctx, endpoint)
+}
+
+// authorize sets the credentials of req and returns the access token it
+// used, if any. A new token is used when rejected is the one the request was
+// previously refused with.
+func (c *Client) authorize(ctx context.Context, r *request, req *http.Request, rejected string) (string, error) {
+	if r.unauthenticated {
+		if r.application_id != "" {
+			req.SetBasicAuth(r.application_id, r.application_secret)
+		}
+		return "", nil
+	}
+
+	accessToken, err := c.tokens.get(ctx, rejected)
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", accessToken))
+	return accessToken, nil
+}
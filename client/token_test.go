@@ -0,0 +1,210 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// tokenServer is a fake token endpoint in front of an API accepting only the
+// tokens it issued.
+type tokenServer struct {
+	*httptest.Server
+
+	lock      sync.Mutex
+	expiresIn int
+	delay     time.Duration
+	issued    int
+	apiCalls  int
+	valid     map[string]bool
+	rejectAll bool
+}
+
+func newTokenServer(t *testing.T, expiresIn int) *tokenServer {
+	t.Helper()
+
+	s := &tokenServer{expiresIn: expiresIn, valid: map[string]bool{}}
+	s.Server = httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/oidc/token" {
+			time.Sleep(s.delay)
+
+			s.lock.Lock()
+			s.issued++
+			token := fmt.Sprintf("token-%d", s.issued)
+			s.valid[token] = !s.rejectAll
+			expiresIn := s.expiresIn
+			s.lock.Unlock()
+
+			_, _ = fmt.Fprintf(w, `{"access_token":%q,"expires_in":%d,"token_type":"Bearer"}`, token, expiresIn)
+			return
+		}
+
+		s.lock.Lock()
+		s.apiCalls++
+		valid := s.valid[strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer ")]
+		s.lock.Unlock()
+
+		if !valid {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		_, _ = w.Write([]byte(`{"id":"id"}`))
+	}))
+	t.Cleanup(s.Close)
+	return s
+}
+
+func (s *tokenServer) client(t *testing.T) *Client {
+	t.Helper()
+
+	client, err := NewClient(&Config{
+		Hostname:          s.Listener.Addr().String(),
+		ApplicationID:     "id",
+		ApplicationSecret: "secret",
+		HttpClient:        s.Client(),
+	})
+	require.NoError(t, err)
+	return client
+}
+
+func (s *tokenServer) revoke() {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	clear(s.valid)
+}
+
+func (s *tokenServer) counts() (issued, apiCalls int) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	return s.issued, s.apiCalls
+}
+
+func TestAccessTokenCaching(t *testing.T) {
+	ctx := context.Background()
+	server := newTokenServer(t, 3600)
+	client := server.client(t)
+
+	for range 5 {
+		_, err := client.RoleGet(ctx, "id")
+		require.NoError(t, err)
+	}
+	issued, apiCalls := server.counts()
+	require.Equal(t, 1, issued)
+	require.Equal(t, 5, apiCalls)
+}
+
+func TestAccessTokenConcurrentRefresh(t *testing.T) {
+	server := newTokenServer(t, 3600)
+	server.delay = 50 * time.Millisecond
+	client := server.client(t)
+
+	var wg sync.WaitGroup
+	for range 20 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := client.RoleGet(context.Background(), "id")
+			require.NoError(t, err)
+		}()
+	}
+	wg.Wait()
+
+	issued, _ := server.counts()
+	require.Equal(t, 1, issued)
+}
+
+func TestAccessTokenExpiry(t *testing.T) {
+	ctx := context.Background()
+
+	// A token valid for a second is renewed after half a second
+	server := newTokenServer(t, 1)
+	client := server.client(t)
+
+	_, err := client.RoleGet(ctx, "id")
+	require.NoError(t, err)
+	_, err = client.RoleGet(ctx, "id")
+	require.NoError(t, err)
+	issued, _ := server.counts()
+	require.Equal(t, 1, issued)
+
+	time.Sleep(600 * time.Millisecond)
+	_, err = client.RoleGet(ctx, "id")
+	require.NoError(t, err)
+	issued, _ = server.counts()
+	require.Equal(t, 2, issued)
+}
+
+func TestAccessTokenRejected(t *testing.T) {
+	ctx := context.Background()
+
+	// A revoked token is replaced and the request sent again
+	server := newTokenServer(t, 3600)
+	client := server.client(t)
+
+	_, err := client.RoleGet(ctx, "id")
+	require.NoError(t, err)
+	server.revoke()
+	_, err = client.RoleGet(ctx, "id")
+	require.NoError(t, err)
+
+	issued, apiCalls := server.counts()
+	require.Equal(t, 2, issued)
+	require.Equal(t, 3, apiCalls)
+
+	// The request is only sent again once
+	server = newTokenServer(t, 3600)
+	server.rejectAll = true
+	client = server.client(t)
+
+	_, err = client.RoleGet(ctx, "id")
+	require.ErrorContains(t, err, "401")
+
+	issued, apiCalls = server.counts()
+	require.Equal(t, 2, issued)
+	require.Equal(t, 2, apiCalls)
+}
+
+func TestTokenSourceCanceledRefresh(t *testing.T) {
+	started := make(chan struct{})
+	calls := 0
+	source := &tokenSource{
+		fetch: func(ctx context.Context) (string, time.Duration, error) {
+			calls++
+			if calls == 1 {
+				close(started)
+				<-ctx.Done()
+				return "", 0, ctx.Err()
+			}
+			return "token", time.Hour, nil
+		},
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	go func() {
+		_, err := source.get(ctx, "")
+		require.ErrorIs(t, err, context.Canceled)
+	}()
+	<-started
+
+	// The refresh made on behalf of the canceled caller does not fail the
+	// others
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		token, err := source.get(context.Background(), "")
+		require.NoError(t, err)
+		require.Equal(t, "token", token)
+	}()
+	time.Sleep(10 * time.Millisecond)
+	cancel()
+	<-done
+
+	require.Equal(t, 2, calls)
+}
@@ -7,13 +7,24 @@ import (
 )
 
 func (c *Client) UserGet(ctx context.Context, id string) (*UserModel, error) {
+	return c.userGet(ctx, id, nil)
+}
+
+// UserGetWithSsoIdentities returns the user along with the identities linked
+// by its enterprise SSO connectors.
+func (c *Client) UserGetWithSsoIdentities(ctx context.Context, id string) (*UserModel, error) {
+	return c.userGet(ctx, id, map[string]string{"includeSsoIdentities": "true"})
+}
+
+func (c *Client) userGet(ctx context.Context, id string, queryParameters map[string]string) (*UserModel, error) {
 	if id == "" {
 		return nil, errEmptyID
 	}
 
 	req := &request{
-		method: http.MethodGet,
-		path:   "api/users/" + id,
+		method:          http.MethodGet,
+		path:            "api/users/" + id,
+		queryParameters: queryParameters,
 	}
 	res, err := expect(200, 404)(c.do(ctx, req))
 	if err != nil {
@@ -31,6 +42,25 @@ func (c *Client) UserGet(ctx context.Context, id string) (*UserModel, error) {
 	return &user, nil
 }
 
+func (c *Client) UserList(ctx context.Context, query_params map[string]string) ([]UserModel, error) {
+	req := &request{
+		method:          http.MethodGet,
+		path:            "api/users",
+		queryParameters: query_params,
+	}
+
+	res, err := expect(200)(c.do(ctx, req))
+	if err != nil {
+		return nil, err
+	}
+
+	var users []UserModel
+	if err := decode(res.Body, &users); err != nil {
+		return nil, err
+	}
+	return users, nil
+}
+
 func (c *Client) UserCreate(ctx context.Context, user *UserModel) (*UserModel, error) {
 	req := &request{
 		method: http.MethodPost,
@@ -86,6 +116,55 @@ func (c *Client) UserUpdate(ctx context.Context, user *UserModel) (*UserModel, e
 	return &returnUser, nil
 }
 
+// UserPasswordUpdate sets the password of the user.
+func (c *Client) UserPasswordUpdate(ctx context.Context, id string, password string) (*UserModel, error) {
+	if id == "" {
+		return nil, errEmptyID
+	}
+
+	req := &request{
+		method: http.MethodPatch,
+		path:   path.Join("api/users", id, "password"),
+		body:   map[string]string{"password": password},
+	}
+
+	res, err := expect(200)(c.do(ctx, req))
+	if err != nil {
+		return nil, err
+	}
+
+	var user UserModel
+	if err := decode(res.Body, &user); err != nil {
+		return nil, err
+	}
+	return &user, nil
+}
+
+// UserIsSuspendedUpdate suspends or reactivates the user. A suspended user
+// cannot sign in and all their sessions are revoked.
+func (c *Client) UserIsSuspendedUpdate(ctx context.Context, id string, suspended bool) (*UserModel, error) {
+	if id == "" {
+		return nil, errEmptyID
+	}
+
+	req := &request{
+		method: http.MethodPatch,
+		path:   path.Join("api/users", id, "is-suspended"),
+		body:   map[string]bool{"isSuspended": suspended},
+	}
+
+	res, err := expect(200)(c.do(ctx, req))
+	if err != nil {
+		return nil, err
+	}
+
+	var user UserModel
+	if err := decode(res.Body, &user); err != nil {
+		return nil, err
+	}
+	return &user, nil
+}
+
 func (c *Client) GetRolesForUser(ctx context.Context, userId string) ([]RoleModel, error) {
 	if userId == "" {
 		return nil, errEmptyID
@@ -0,0 +1,47 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"path"
+)
+
+// UserIdentityUpdate links the identity to the user for the given target,
+// replacing the identity already linked for this target if any. Logto does not
+// verify the identity with the identity provider.
+func (c *Client) UserIdentityUpdate(ctx context.Context, userId string, target string, identity *IdentityModel) (map[string]IdentityModel, error) {
+	if userId == "" || target == "" {
+		return nil, errEmptyID
+	}
+
+	req := &request{
+		method: http.MethodPut,
+		path:   path.Join("api/users", userId, "identities", target),
+		body:   identity,
+	}
+
+	res, err := expect(200, 201)(c.do(ctx, req))
+	if err != nil {
+		return nil, err
+	}
+
+	var identities map[string]IdentityModel
+	if err := decode(res.Body, &identities); err != nil {
+		return nil, err
+	}
+	return identities, nil
+}
+
+func (c *Client) UserIdentityDelete(ctx context.Context, userId string, target string) error {
+	if userId == "" || target == "" {
+		return errEmptyID
+	}
+
+	req := &request{
+		method: http.MethodDelete,
+		path:   path.Join("api/users", userId, "identities", target),
+	}
+
+	_, err := expect(200)(c.do(ctx, req))
+	return err
+}
@@ -0,0 +1,51 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestUserIdentity(t *testing.T) {
+	ctx := context.Background()
+	config := newTestConfig(t)
+	client, err := NewClient(config)
+	require.NoError(t, err)
+
+	user, err := client.UserCreate(ctx, &UserModel{Username: "identity"})
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		require.NoError(t, client.UserDelete(ctx, user.ID))
+	})
+	require.Empty(t, user.Identities)
+
+	identities, err := client.UserIdentityUpdate(ctx, user.ID, "github", &IdentityModel{
+		UserID:  "1234",
+		Details: json.RawMessage(`{"email":"identity@example.com"}`),
+	})
+	require.NoError(t, err)
+	require.Equal(t, "1234", identities["github"].UserID)
+	require.JSONEq(t, `{"email":"identity@example.com"}`, string(identities["github"].Details))
+
+	// Linking an identity again replaces it
+	identities, err = client.UserIdentityUpdate(ctx, user.ID, "github", &IdentityModel{UserID: "5678"})
+	require.NoError(t, err)
+	require.Equal(t, map[string]IdentityModel{"github": {UserID: "5678"}}, identities)
+
+	user, err = client.UserGetWithSsoIdentities(ctx, user.ID)
+	require.NoError(t, err)
+	require.Equal(t, identities, user.Identities)
+	require.Empty(t, user.SsoIdentities)
+
+	require.NoError(t, client.UserIdentityDelete(ctx, user.ID, "github"))
+	require.Error(t, client.UserIdentityDelete(ctx, user.ID, "github"))
+
+	user, err = client.UserGet(ctx, user.ID)
+	require.NoError(t, err)
+	require.Empty(t, user.Identities)
+
+	_, err = client.UserIdentityUpdate(ctx, "", "github", &IdentityModel{UserID: "1234"})
+	require.ErrorIs(t, err, errEmptyID)
+}
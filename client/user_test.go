@@ -2,17 +2,15 @@ package client
 
 import (
 	"context"
-	"os"
+	"encoding/json"
 	"testing"
 
-	"github.com/rs/zerolog"
 	"github.com/stretchr/testify/require"
 )
 
 func TestUser(t *testing.T) {
 	ctx := context.Background()
-	config := DefaultConfig()
-	config.Logger = zerolog.New(os.Stdout)
+	config := newTestConfig(t)
 	client, err := NewClient(config)
 	require.NoError(t, err)
 
@@ -30,6 +28,8 @@ func TestUser(t *testing.T) {
 			MiddleName: "test",
 			Nickname:   "test",
 		},
+		CustomData: json.RawMessage(`{}`),
+		Identities: map[string]IdentityModel{},
 	}
 	user, err = client.UserCreate(
 		ctx,
@@ -48,8 +48,13 @@ func TestUser(t *testing.T) {
 	require.NoError(t, err)
 	require.NotEmpty(t, user.ID)
 
+	require.NotNil(t, user.CreatedAt)
+	require.NotNil(t, user.UpdatedAt)
+
 	userId := user.ID
 	user.ID = ""
+	user.CreatedAt = nil
+	user.UpdatedAt = nil
 	require.Equal(t, expected, user)
 
 	user, err = client.UserGet(ctx, userId)
@@ -60,6 +65,10 @@ func TestUser(t *testing.T) {
 	require.Equal(t, "test", user.Username)
 	require.Equal(t, "test", user.Name)
 
+	users, err := client.UserList(ctx, nil)
+	require.NoError(t, err)
+	require.NotEmpty(t, users)
+
 	user.Name = "test update"
 	user, err = client.UserUpdate(ctx, user)
 	require.NoError(t, err)
@@ -70,3 +79,166 @@ func TestUser(t *testing.T) {
 	err = client.UserDelete(ctx, user.ID)
 	require.NoError(t, err)
 }
+
+func TestUserPassword(t *testing.T) {
+	ctx := context.Background()
+	config := newTestConfig(t)
+	client, err := NewClient(config)
+	require.NoError(t, err)
+
+	user, err := client.UserCreate(ctx, &UserModel{Username: "password", Password: "s3cr3t-Passw0rd"})
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		require.NoError(t, client.UserDelete(ctx, user.ID))
+	})
+	require.True(t, user.HasPassword)
+
+	user, err = client.UserPasswordUpdate(ctx, user.ID, "an0ther-Passw0rd")
+	require.NoError(t, err)
+	require.Equal(t, "password", user.Username)
+
+	_, err = client.UserPasswordUpdate(ctx, "", "an0ther-Passw0rd")
+	require.ErrorIs(t, err, errEmptyID)
+
+	digest, err := client.UserCreate(ctx, &UserModel{
+		Username:          "digest",
+		PasswordDigest:    "5f4dcc3b5aa765d61d8327deb882cf99",
+		PasswordAlgorithm: "MD5",
+	})
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		require.NoError(t, client.UserDelete(ctx, digest.ID))
+	})
+
+	_, err = client.UserCreate(ctx, &UserModel{
+		Username:          "invalid",
+		PasswordDigest:    "5f4dcc3b5aa765d61d8327deb882cf99",
+		PasswordAlgorithm: "ROT13",
+	})
+	require.Error(t, err)
+}
+
+func TestUserIsSuspended(t *testing.T) {
+	ctx := context.Background()
+	config := newTestConfig(t)
+	client, err := NewClient(config)
+	require.NoError(t, err)
+
+	user, err := client.UserCreate(ctx, &UserModel{Username: "suspended"})
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		require.NoError(t, client.UserDelete(ctx, user.ID))
+	})
+	require.False(t, user.IsSuspended)
+	require.Nil(t, user.LastSignInAt)
+
+	user, err = client.UserIsSuspendedUpdate(ctx, user.ID, true)
+	require.NoError(t, err)
+	require.True(t, user.IsSuspended)
+
+	user, err = client.UserGet(ctx, user.ID)
+	require.NoError(t, err)
+	require.True(t, user.IsSuspended)
+
+	user, err = client.UserIsSuspendedUpdate(ctx, user.ID, false)
+	require.NoError(t, err)
+	require.False(t, user.IsSuspended)
+}
+
+func TestUsersAllSearch(t *testing.T) {
+	ctx := context.Background()
+	config := newTestConfig(t)
+	client, err := NewClient(config)
+	require.NoError(t, err)
+
+	for _, user := range []*UserModel{
+		{Username: "search_alice", PrimaryEmail: "alice@search.test"},
+		{Username: "search_bob", PrimaryEmail: "bob@other-search.test"},
+	} {
+		user, err := client.UserCreate(ctx, user)
+		require.NoError(t, err)
+		t.Cleanup(func() {
+			require.NoError(t, client.UserDelete(ctx, user.ID))
+		})
+	}
+
+	search := func(params map[string]string) []string {
+		var usernames []string
+		for user, err := range client.UsersAll(ctx, params) {
+			require.NoError(t, err)
+			usernames = append(usernames, user.Username)
+		}
+		return usernames
+	}
+
+	require.ElementsMatch(t, []string{"search_alice", "search_bob"}, search(map[string]string{"search": "search_%"}))
+	require.ElementsMatch(t, []string{"search_alice"}, search(map[string]string{"search.primaryEmail": "%@search.test"}))
+	require.ElementsMatch(t, []string{"search_alice", "search_bob"}, search(map[string]string{
+		"search.username":     "SEARCH_ALICE",
+		"search.primaryEmail": "%@other-search.test",
+	}))
+	require.Empty(t, search(map[string]string{
+		"search.username":     "SEARCH_ALICE",
+		"search.primaryEmail": "%@other-search.test",
+		"joint":               "and",
+	}))
+	require.Empty(t, search(map[string]string{
+		"search.username": "SEARCH_ALICE",
+		"isCaseSensitive": "true",
+	}))
+	require.ElementsMatch(t, []string{"search_bob"}, search(map[string]string{
+		"search.username": "search_bob",
+		"mode":            "exact",
+	}))
+}
+
+func TestUserProfile(t *testing.T) {
+	ctx := context.Background()
+	config := newTestConfig(t)
+	client, err := NewClient(config)
+	require.NoError(t, err)
+
+	phone := "33612345678"
+	avatar := "https://example.com/avatar.png"
+	profile := &Profile{
+		Nickname:  "test",
+		Website:   "https://example.com",
+		Gender:    "female",
+		Birthdate: "1990-01-31",
+		Zoneinfo:  "Europe/Paris",
+		Locale:    "fr-FR",
+		Address: &Address{
+			StreetAddress: "1 rue de Rivoli",
+			Locality:      "Paris",
+			PostalCode:    "75001",
+			Country:       "FR",
+		},
+	}
+	user, err := client.UserCreate(ctx, &UserModel{
+		Username:     "profile",
+		PrimaryPhone: &phone,
+		Avatar:       &avatar,
+		Profile:      profile,
+	})
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		require.NoError(t, client.UserDelete(ctx, user.ID))
+	})
+	require.Equal(t, &phone, user.PrimaryPhone)
+	require.Equal(t, &avatar, user.Avatar)
+	require.Equal(t, profile, user.Profile)
+
+	_, err = client.UserUpdate(ctx, &UserModel{ID: user.ID, PrimaryPhone: new(string)})
+	require.NoError(t, err)
+
+	// The profile is replaced as a whole
+	user, err = client.UserUpdate(ctx, &UserModel{ID: user.ID, Profile: &Profile{Locale: "en"}})
+	require.NoError(t, err)
+	require.True(t, user.PrimaryPhone == nil || *user.PrimaryPhone == "")
+	require.Equal(t, &avatar, user.Avatar)
+	require.Equal(t, &Profile{Locale: "en"}, user.Profile)
+
+	invalid := "+33612345678"
+	_, err = client.UserUpdate(ctx, &UserModel{ID: user.ID, PrimaryPhone: &invalid})
+	require.Error(t, err)
+}
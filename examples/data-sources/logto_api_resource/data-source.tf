@@ -0,0 +1,3 @@
+data "logto_api_resource" "api" {
+  indicator = "https://api.example.com"
+}
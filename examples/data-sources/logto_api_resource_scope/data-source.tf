@@ -0,0 +1,8 @@
+data "logto_api_resource" "api" {
+  indicator = "https://api.example.com"
+}
+
+data "logto_api_resource_scope" "read" {
+  resource_id = data.logto_api_resource.api.id
+  name        = "read"
+}
@@ -0,0 +1,7 @@
+data "logto_application" "by_name" {
+  name = "backend"
+}
+
+data "logto_application" "by_id" {
+  id = "applicationId"
+}
@@ -0,0 +1,7 @@
+data "logto_application_secrets" "backend" {
+  application_id = "applicationId"
+}
+
+output "secret_names" {
+  value = data.logto_application_secrets.backend.secrets[*].name
+}
@@ -0,0 +1,3 @@
+data "logto_role" "admin" {
+  name = "admin"
+}
@@ -0,0 +1,7 @@
+data "logto_user" "by_username" {
+  username = "username"
+}
+
+data "logto_user" "by_email" {
+  primary_email = "user@example.com"
+}
@@ -0,0 +1,4 @@
+list "logto_api_resource" "all" {
+  provider         = logto
+  include_resource = true
+}
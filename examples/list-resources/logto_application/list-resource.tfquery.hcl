@@ -0,0 +1,8 @@
+list "logto_application" "spa" {
+  provider = logto
+
+  config {
+    search = "%frontend%"
+    type   = "SPA"
+  }
+}
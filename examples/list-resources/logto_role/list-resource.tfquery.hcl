@@ -0,0 +1,7 @@
+list "logto_role" "machine_to_machine" {
+  provider = logto
+
+  config {
+    type = "MachineToMachine"
+  }
+}
@@ -0,0 +1,22 @@
+# Lists the users whose primary email belongs to example.com.
+list "logto_user" "example" {
+  provider = logto
+
+  config {
+    primary_email = "%@example.com"
+  }
+}
+
+# Lists the user whose username is exactly jdoe and whose primary email
+# belongs to example.com, including their attributes.
+list "logto_user" "jdoe" {
+  provider         = logto
+  include_resource = true
+
+  config {
+    username      = "jdoe"
+    primary_email = "%@example.com"
+    match_mode    = "like"
+    joint_mode    = "and"
+  }
+}
@@ -0,0 +1,6 @@
+# A scope is imported using the indicator of its API resource and its name
+# separated by a colon.
+terraform import logto_api_resource_scope.api_resource_scope https://api.example.com:read:orders
+
+# It can also be imported using the API resource ID and the scope ID.
+terraform import logto_api_resource_scope.api_resource_scope <resource_id>/<scope_id>
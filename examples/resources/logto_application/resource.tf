@@ -3,4 +3,37 @@ resource "logto_application" "app" {
   name        = "test"
   description = "test app description"
   type        = "Native"
-}
\ No newline at end of file
+}
+resource "logto_role" "m2m_role" {
+  name        = "m2m_role"
+  description = "m2m_role_description"
+  type        = "MachineToMachine"
+}
+
+resource "logto_application" "m2m_app" {
+  name     = "m2m"
+  type     = "MachineToMachine"
+  role_ids = [logto_role.m2m_role.id]
+}
+
+resource "logto_application" "web_app" {
+  name                   = "web"
+  type                   = "Traditional"
+  redirect_uris          = ["https://example.com/callback"]
+  backchannel_logout_uri = "https://example.com/logout"
+  logo_uri               = "https://example.com/logo.png"
+  id_token_ttl           = 600
+  rotate_refresh_token   = false
+}
+
+resource "logto_application" "protected_app" {
+  name = "internal-dashboard"
+  type = "Protected"
+
+  protected_app = {
+    sub_domain     = "dashboard"
+    origin         = "https://dashboard.internal.example.com"
+    page_rules     = ["^/admin"]
+    custom_domains = ["dashboard.example.com"]
+  }
+}
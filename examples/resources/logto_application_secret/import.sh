@@ -0,0 +1,3 @@
+# Secrets are imported using the application ID and the secret name
+# separated by a slash.
+terraform import logto_application_secret.backend <application_id>/<name>
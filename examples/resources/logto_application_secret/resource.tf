@@ -0,0 +1,15 @@
+resource "logto_application" "backend" {
+  name = "backend"
+  type = "MachineToMachine"
+}
+
+resource "logto_application_secret" "backend" {
+  application_id = logto_application.backend.id
+  name           = "backend-secret"
+  expires_at     = 4102444800000 # 2100-01-01T00:00:00Z
+
+  # Changing any of these values creates a new secret.
+  keepers = {
+    rotation = "2024-01"
+  }
+}
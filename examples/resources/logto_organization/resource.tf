@@ -0,0 +1,14 @@
+resource "logto_organization" "organization" {
+  name            = "organization_name"
+  description     = "organization_description"
+  is_mfa_required = true
+
+  custom_data = jsonencode({
+    plan = "enterprise"
+  })
+
+  branding = {
+    logo_url = "https://example.com/logo.png"
+    favicon  = "https://example.com/favicon.ico"
+  }
+}
@@ -0,0 +1,3 @@
+# Memberships are imported using the organization ID and the user ID
+# separated by a slash.
+terraform import logto_organization_membership.membership <organization_id>/<user_id>
@@ -0,0 +1,21 @@
+resource "logto_organization" "organization" {
+  name = "organization_name"
+}
+
+resource "logto_organization_role" "member" {
+  name = "member"
+}
+
+resource "logto_user" "user" {
+  username      = "username"
+  primary_email = "user@example.com"
+}
+
+resource "logto_organization_membership" "membership" {
+  organization_id = logto_organization.organization.id
+  user_id         = logto_user.user.id
+
+  organization_role_ids = [
+    logto_organization_role.member.id,
+  ]
+}
@@ -0,0 +1,27 @@
+resource "logto_organization_scope" "read_members" {
+  name        = "read:members"
+  description = "Read the members of the organization."
+}
+
+resource "logto_api_resource" "api_resource" {
+  name      = "api_resource_name"
+  indicator = "https://api-resource.test"
+}
+
+resource "logto_api_resource_scope" "read_invoices" {
+  name        = "read:invoices"
+  resource_id = logto_api_resource.api_resource.id
+}
+
+resource "logto_organization_role" "admin" {
+  name        = "admin"
+  description = "Administrator of the organization."
+
+  organization_scope_ids = [
+    logto_organization_scope.read_members.id,
+  ]
+
+  resource_scope_ids = [
+    logto_api_resource_scope.read_invoices.id,
+  ]
+}
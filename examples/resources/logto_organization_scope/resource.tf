@@ -0,0 +1,4 @@
+resource "logto_organization_scope" "read_members" {
+  name        = "read:members"
+  description = "Read the members of the organization."
+}
@@ -0,0 +1,4 @@
+# A role is imported using its name or its ID. When the role has scopes, they are
+# then managed by the scope_ids attribute: a configuration without scope_ids
+# stops managing them on the next apply and leaves them unchanged.
+terraform import logto_role.test_role role_name
@@ -0,0 +1,4 @@
+# Role assignments are imported using the role ID. The users of the role are
+# managed for roles of type User and the applications for machine-to-machine
+# roles.
+terraform import logto_role_assignment.support <role_id>
@@ -0,0 +1,23 @@
+resource "logto_role" "support" {
+  name        = "support"
+  description = "Can read the tickets"
+}
+
+resource "logto_user" "alice" {
+  username = "alice"
+}
+
+resource "logto_user" "bob" {
+  username = "bob"
+}
+
+# The role is assigned to exactly these users. The users must not list the
+# role in their role_ids attribute.
+resource "logto_role_assignment" "support" {
+  role_id = logto_role.support.id
+
+  user_ids = [
+    logto_user.alice.id,
+    logto_user.bob.id,
+  ]
+}
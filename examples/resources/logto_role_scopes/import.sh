@@ -0,0 +1,3 @@
+# The scopes of a role are imported using the role ID, all the scopes of the
+# role are then managed by the resource.
+terraform import logto_role_scopes.support_billing <role_id>
@@ -0,0 +1,23 @@
+resource "logto_api_resource" "billing" {
+  name      = "billing"
+  indicator = "https://billing.example.com"
+}
+
+resource "logto_api_resource_scope" "read_invoices" {
+  name        = "read:invoices"
+  resource_id = logto_api_resource.billing.id
+}
+
+data "logto_role" "support" {
+  name = "support"
+}
+
+# Grant the scopes of the billing API to a role managed in another module.
+# The role must not set scope_ids.
+resource "logto_role_scopes" "support_billing" {
+  role_id = data.logto_role.support.id
+
+  scope_ids = [
+    logto_api_resource_scope.read_invoices.id,
+  ]
+}
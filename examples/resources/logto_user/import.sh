@@ -0,0 +1,3 @@
+# A user is imported using their username, their primary email or their ID.
+terraform import logto_user.user user_primary_email@example.fr
+terraform import logto_user.admin admin
@@ -8,15 +8,55 @@ resource "logto_role" "role" {
 resource "logto_user" "user" {
   name          = "user_name"
   primary_email = "user_primary_email@example.fr"
+  primary_phone = "+33612345678"
+  avatar        = "https://example.com/avatar.png"
 
   profile = {
     family_name = "family name"
     given_name  = "given name"
     middle_name = "middle name"
     nickname    = "nickname"
+    locale      = "fr-FR"
+    zoneinfo    = "Europe/Paris"
+
+    address = {
+      street_address = "1 rue de Rivoli"
+      locality       = "Paris"
+      postal_code    = "75001"
+      country        = "France"
+    }
   }
 
   role_ids = [
     logto_role.role.id
   ]
+
+  # Only the feature flags are managed by Terraform, the other keys can be
+  # written by the application at runtime.
+  custom_data_keys = ["features"]
+  custom_data      = jsonencode({
+    features = ["beta"]
+  })
+}
+
+variable "admin_password" {
+  type      = string
+  sensitive = true
+}
+
+resource "logto_user" "admin" {
+  username = "admin"
+
+  # The user is suspended rather than deleted when the resource is destroyed.
+  deletion_protection = true
+
+  # Increment password_version to set the password again.
+  password         = var.admin_password
+  password_version = 1
+}
+
+resource "logto_user" "migrated" {
+  username           = "migrated"
+  password_digest    = "$2a$10$N9qo8uLOickgx2ZMRZoMyeIjZAgcfl7p92ldGxad68LJZdL17lhWy"
+  password_algorithm = "Bcrypt"
 }
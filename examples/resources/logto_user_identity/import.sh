@@ -0,0 +1,3 @@
+# Identities are imported using the user ID and the target of the identity
+# separated by a slash.
+terraform import logto_user_identity.github <user_id>/<target>
@@ -0,0 +1,16 @@
+resource "logto_user" "user" {
+  username      = "username"
+  primary_email = "user@example.com"
+}
+
+# Link the GitHub account of a user migrated from another identity provider,
+# so that they can sign in with GitHub right away.
+resource "logto_user_identity" "github" {
+  user_id     = logto_user.user.id
+  target      = "github"
+  identity_id = "1234567"
+
+  details = jsonencode({
+    email = "user@example.com"
+  })
+}
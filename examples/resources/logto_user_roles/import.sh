@@ -0,0 +1,4 @@
+# The roles of a user are imported using the user ID, in authoritative mode.
+# Switching the imported resource to the additive mode does not remove the
+# roles missing from role_ids.
+terraform import logto_user_roles.user <user_id>
@@ -0,0 +1,19 @@
+resource "logto_role" "editor" {
+  name        = "editor"
+  description = "Can edit the content"
+}
+
+resource "logto_user" "user" {
+  username = "username"
+}
+
+# Only the editor role is managed, the roles granted by the default roles
+# of the tenant or from the admin console are left untouched.
+resource "logto_user_roles" "user" {
+  user_id = logto_user.user.id
+  mode    = "additive"
+
+  role_ids = [
+    logto_role.editor.id,
+  ]
+}
@@ -0,0 +1,488 @@
+// Package export generates the Terraform configuration of the objects of an
+// existing Logto tenant, along with the import blocks bringing them under
+// Terraform.
+//
+// The applications, users, roles, API resources and scopes of the tenant are
+// exported. The roles of the users and applications and the scopes of the
+// roles refer to the exported resources instead of their identifiers, so the
+// configuration can be reused in another tenant once imported. The generated
+// configuration is a starting point: the attributes that cannot be read back
+// from Logto, like passwords and application secrets, are left out and
+// running terraform plan after the import shows what is still missing.
+package export
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/Lenstra/terraform-provider-logto/client"
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclwrite"
+	"github.com/zclconf/go-cty/cty"
+	ctyjson "github.com/zclconf/go-cty/cty/json"
+)
+
+// Command runs the export subcommand with the given command line arguments.
+// The client is configured with the environment variables used by the
+// provider.
+func Command(ctx context.Context, args []string, stderr io.Writer) error {
+	flags := flag.NewFlagSet("export", flag.ContinueOnError)
+	flags.SetOutput(stderr)
+	dir := flags.String("dir", ".", "the directory the .tf files are written to")
+	flags.Usage = func() {
+		fmt.Fprintln(stderr, "Usage: terraform-provider-logto export [-dir <directory>]")
+		fmt.Fprintln(stderr)
+		fmt.Fprintln(stderr, "Generate the configuration of the Logto tenant configured by the LOGTO_* environment variables.")
+		fmt.Fprintln(stderr)
+		flags.PrintDefaults()
+	}
+	if err := flags.Parse(args); errors.Is(err, flag.ErrHelp) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+	if flags.NArg() != 0 {
+		flags.Usage()
+		return fmt.Errorf("unexpected argument %q", flags.Arg(0))
+	}
+
+	config := client.DefaultConfig()
+	if token := os.Getenv("LOGTO_ACCESS_TOKEN"); token != "" {
+		config.Authenticator = &client.StaticToken{Token: token}
+	}
+	c, err := client.NewClient(config)
+	if err != nil {
+		return err
+	}
+
+	files, err := Generate(ctx, c)
+	if err != nil {
+		return err
+	}
+	return Write(*dir, files)
+}
+
+// Write writes files to dir, it refuses to overwrite existing files.
+func Write(dir string, files map[string][]byte) error {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return err
+	}
+
+	names := make([]string, 0, len(files))
+	for name := range files {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+
+	for _, name := range names {
+		path := filepath.Join(dir, name)
+		if _, err := os.Stat(path); err == nil {
+			return fmt.Errorf("%s already exists", path)
+		} else if !errors.Is(err, os.ErrNotExist) {
+			return err
+		}
+	}
+	for _, name := range names {
+		if err := os.WriteFile(filepath.Join(dir, name), files[name], 0o644); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Generate returns the content of the .tf files describing the tenant, by
+// file name. The files of the kinds of objects the tenant has none of are
+// omitted.
+func Generate(ctx context.Context, c *client.Client) (map[string][]byte, error) {
+	e := &exporter{
+		client:       c,
+		names:        map[string][]string{},
+		apiResources: map[string]hcl.Traversal{},
+		scopes:       map[string]hcl.Traversal{},
+		roles:        map[string]hcl.Traversal{},
+		userRoles:    map[string][]string{},
+		appRoles:     map[string][]string{},
+		files:        map[string][]byte{},
+	}
+
+	// The API resources are exported first so that the roles can refer to
+	// their scopes, and the roles before the users and applications.
+	for _, step := range []func(context.Context) error{
+		e.exportApiResources,
+		e.exportRoles,
+		e.exportUsers,
+		e.exportApplications,
+	} {
+		if err := step(ctx); err != nil {
+			return nil, err
+		}
+	}
+	return e.files, nil
+}
+
+type exporter struct {
+	client *client.Client
+
+	// names holds the names already given to the resources of each type.
+	names map[string][]string
+
+	// The addresses of the exported resources, by identifier.
+	apiResources map[string]hcl.Traversal
+	scopes       map[string]hcl.Traversal
+	roles        map[string]hcl.Traversal
+
+	// The identifiers of the roles of the users and applications.
+	userRoles map[string][]string
+	appRoles  map[string][]string
+
+	files map[string][]byte
+}
+
+func (e *exporter) exportApiResources(ctx context.Context) error {
+	f := hclwrite.NewEmptyFile()
+	for resource, err := range e.client.ApiResourcesAll(ctx, nil) {
+		if err != nil {
+			return fmt.Errorf("failed to list API resources: %w", err)
+		}
+		if isManagementApi(resource) {
+			continue
+		}
+
+		block, address := e.resource(f, "logto_api_resource", resource.Name, resource.ID)
+		body := block.Body()
+		setString(body, "name", resource.Name)
+		setString(body, "indicator", resource.Indicator)
+		if resource.AccessTokenTtl != nil {
+			body.SetAttributeValue("access_token_ttl", cty.NumberFloatVal(*resource.AccessTokenTtl))
+		}
+		e.apiResources[resource.ID] = attribute(address, "id")
+
+		for scope, err := range e.client.ApiResourceScopesAll(ctx, resource.ID, nil) {
+			if err != nil {
+				return fmt.Errorf("failed to list the scopes of the API resource %q: %w", resource.Name, err)
+			}
+
+			block, address := e.resource(f, "logto_api_resource_scope", resource.Name+"_"+scope.Name, resource.ID+"/"+scope.ID)
+			body := block.Body()
+			setString(body, "name", scope.Name)
+			body.SetAttributeTraversal("resource_id", e.apiResources[resource.ID])
+			setString(body, "description", scope.Description)
+			e.scopes[scope.ID] = attribute(address, "id")
+		}
+	}
+	e.save("api_resources.tf", f)
+	return nil
+}
+
+func (e *exporter) exportRoles(ctx context.Context) error {
+	f := hclwrite.NewEmptyFile()
+	for role, err := range e.client.RolesAll(ctx, nil) {
+		if err != nil {
+			return fmt.Errorf("failed to list roles: %w", err)
+		}
+
+		block, address := e.resource(f, "logto_role", role.Name, role.ID)
+		body := block.Body()
+		setString(body, "name", role.Name)
+		body.SetAttributeValue("description", cty.StringVal(role.Description))
+		setString(body, "type", role.Type)
+		if role.IsDefault {
+			body.SetAttributeValue("is_default", cty.True)
+		}
+		e.roles[role.ID] = attribute(address, "id")
+
+		var scopeIds []string
+		for scope, err := range e.client.RoleScopesAll(ctx, role.ID, nil) {
+			if err != nil {
+				return fmt.Errorf("failed to list the scopes of the role %q: %w", role.Name, err)
+			}
+			scopeIds = append(scopeIds, scope.ID)
+		}
+		if len(scopeIds) != 0 {
+			body.SetAttributeRaw("scope_ids", references(e.scopes, scopeIds))
+		}
+
+		switch role.Type {
+		case "MachineToMachine":
+			for app, err := range e.client.RoleApplicationsAll(ctx, role.ID, nil) {
+				if err != nil {
+					return fmt.Errorf("failed to list the applications of the role %q: %w", role.Name, err)
+				}
+				e.appRoles[app.ID] = append(e.appRoles[app.ID], role.ID)
+			}
+		default:
+			for user, err := range e.client.RoleUsersAll(ctx, role.ID, nil) {
+				if err != nil {
+					return fmt.Errorf("failed to list the users of the role %q: %w", role.Name, err)
+				}
+				e.userRoles[user.ID] = append(e.userRoles[user.ID], role.ID)
+			}
+		}
+	}
+	e.save("roles.tf", f)
+	return nil
+}
+
+func (e *exporter) exportUsers(ctx context.Context) error {
+	f := hclwrite.NewEmptyFile()
+	for user, err := range e.client.UsersAll(ctx, nil) {
+		if err != nil {
+			return fmt.Errorf("failed to list users: %w", err)
+		}
+
+		label := user.Username
+		if label == "" {
+			label = user.PrimaryEmail
+		}
+		if label == "" {
+			label = user.Name
+		}
+
+		block, _ := e.resource(f, "logto_user", label, user.ID)
+		body := block.Body()
+		setString(body, "username", user.Username)
+		setString(body, "primary_email", user.PrimaryEmail)
+		if user.PrimaryPhone != nil && *user.PrimaryPhone != "" {
+			body.SetAttributeValue("primary_phone", cty.StringVal("+"+strings.TrimPrefix(*user.PrimaryPhone, "+")))
+		}
+		setString(body, "name", user.Name)
+		if user.Avatar != nil {
+			setString(body, "avatar", *user.Avatar)
+		}
+		if user.IsSuspended {
+			body.SetAttributeValue("is_suspended", cty.True)
+		}
+		if profile := profileValue(user.Profile); !profile.IsNull() {
+			body.SetAttributeValue("profile", profile)
+		}
+		if err := setCustomData(body, user.CustomData); err != nil {
+			return fmt.Errorf("failed to export the custom data of the user %q: %w", user.ID, err)
+		}
+		if roleIds := e.userRoles[user.ID]; len(roleIds) != 0 {
+			body.SetAttributeRaw("role_ids", references(e.roles, roleIds))
+		}
+	}
+	e.save("users.tf", f)
+	return nil
+}
+
+func (e *exporter) exportApplications(ctx context.Context) error {
+	f := hclwrite.NewEmptyFile()
+	for app, err := range e.client.ApplicationsAll(ctx, nil) {
+		if err != nil {
+			return fmt.Errorf("failed to list applications: %w", err)
+		}
+
+		block, _ := e.resource(f, "logto_application", app.Name, app.ID)
+		body := block.Body()
+		setString(body, "name", app.Name)
+		setString(body, "type", app.Type)
+		setString(body, "description", app.Description)
+		if app.IsThirdParty {
+			body.SetAttributeValue("is_third_party", cty.True)
+		}
+		if metadata := app.OidcClientMetadata; metadata != nil {
+			setStrings(body, "redirect_uris", metadata.RedirectUris)
+			setStrings(body, "post_logout_redirect_uris", metadata.PostLogoutRedirectUris)
+			setString(body, "backchannel_logout_uri", metadata.BackchannelLogoutUri)
+			setString(body, "logo_uri", metadata.LogoUri)
+		}
+		if metadata := app.CustomClientMetadata; metadata != nil {
+			setStrings(body, "cors_allowed_origins", metadata.CorsAllowedOrigins)
+		}
+		if metadata := app.ProtectedAppMetadata; metadata != nil {
+			// Logto does not return the sub domain, it is the first label of
+			// the host the app is served at.
+			subDomain, _, _ := strings.Cut(metadata.Host, ".")
+			body.SetAttributeValue("protected_app", cty.ObjectVal(map[string]cty.Value{
+				"origin":     cty.StringVal(metadata.Origin),
+				"sub_domain": cty.StringVal(subDomain),
+			}))
+		}
+		if err := setCustomData(body, app.CustomData); err != nil {
+			return fmt.Errorf("failed to export the custom data of the application %q: %w", app.ID, err)
+		}
+		if roleIds := e.appRoles[app.ID]; len(roleIds) != 0 {
+			body.SetAttributeRaw("role_ids", references(e.roles, roleIds))
+		}
+	}
+	e.save("applications.tf", f)
+	return nil
+}
+
+// resource appends to f the block of a resource of the given type named
+// after label, followed by the import block of the object id. It returns the
+// resource block and its address.
+func (e *exporter) resource(f *hclwrite.File, resourceType, label, id string) (*hclwrite.Block, hcl.Traversal) {
+	name := e.name(resourceType, label)
+	address := hcl.Traversal{
+		hcl.TraverseRoot{Name: resourceType},
+		hcl.TraverseAttr{Name: name},
+	}
+
+	body := f.Body()
+	if len(body.Blocks()) != 0 {
+		body.AppendNewline()
+	}
+	block := body.AppendNewBlock("resource", []string{resourceType, name})
+	body.AppendNewline()
+	imp := body.AppendNewBlock("import", nil).Body()
+	imp.SetAttributeTraversal("to", address)
+	imp.SetAttributeValue("id", cty.StringVal(id))
+
+	return block, address
+}
+
+// name returns a valid and unique name for a resource of the given type from
+// label, the name of the object in Logto.
+func (e *exporter) name(resourceType, label string) string {
+	var b strings.Builder
+	for _, r := range strings.ToLower(label) {
+		switch {
+		case r >= 'a' && r <= 'z', r >= '0' && r <= '9':
+			b.WriteRune(r)
+		case b.Len() != 0 && !strings.HasSuffix(b.String(), "_"):
+			b.WriteRune('_')
+		}
+	}
+	base := strings.TrimSuffix(b.String(), "_")
+	if base == "" || base[0] < 'a' {
+		// Resource names must start with a letter.
+		base = strings.TrimPrefix(resourceType, "logto_") + "_" + base
+		base = strings.TrimSuffix(base, "_")
+	}
+
+	name := base
+	for i := 2; slices.Contains(e.names[resourceType], name); i++ {
+		name = fmt.Sprintf("%s_%d", base, i)
+	}
+	e.names[resourceType] = append(e.names[resourceType], name)
+	return name
+}
+
+func (e *exporter) save(name string, f *hclwrite.File) {
+	if len(f.Body().Blocks()) != 0 {
+		e.files[name] = hclwrite.Format(f.Bytes())
+	}
+}
+
+// isManagementApi reports whether resource is the Logto Management API, which
+// is built into every tenant and cannot be managed.
+func isManagementApi(resource client.ApiResourceModel) bool {
+	return strings.HasSuffix(resource.Indicator, ".logto.app/api")
+}
+
+func attribute(address hcl.Traversal, name string) hcl.Traversal {
+	return append(slices.Clone(address), hcl.TraverseAttr{Name: name})
+}
+
+// references returns a list referring to the exported objects of ids. The
+// identifiers of the objects that have not been exported, like the scopes of
+// the Logto Management API, are kept as is.
+func references(addresses map[string]hcl.Traversal, ids []string) hclwrite.Tokens {
+	elements := make([]hclwrite.Tokens, 0, len(ids))
+	for _, id := range ids {
+		if address, found := addresses[id]; found {
+			elements = append(elements, hclwrite.TokensForTraversal(address))
+		} else {
+			elements = append(elements, hclwrite.TokensForValue(cty.StringVal(id)))
+		}
+	}
+	return hclwrite.TokensForTuple(elements)
+}
+
+// setString sets the attribute name of body, unless value is empty.
+func setString(body *hclwrite.Body, name, value string) {
+	if value != "" {
+		body.SetAttributeValue(name, cty.StringVal(value))
+	}
+}
+
+// setStrings sets the attribute name of body, unless values is empty.
+func setStrings(body *hclwrite.Body, name string, values []string) {
+	if len(values) == 0 {
+		return
+	}
+	elements := make([]cty.Value, 0, len(values))
+	for _, value := range values {
+		elements = append(elements, cty.StringVal(value))
+	}
+	body.SetAttributeValue(name, cty.ListVal(elements))
+}
+
+// setCustomData sets the custom_data attribute of body using jsonencode so
+// that it stays readable, unless the custom data is empty.
+func setCustomData(body *hclwrite.Body, data []byte) error {
+	if len(data) == 0 || string(data) == "{}" || string(data) == "null" {
+		return nil
+	}
+
+	ty, err := ctyjson.ImpliedType(data)
+	if err != nil {
+		return err
+	}
+	value, err := ctyjson.Unmarshal(data, ty)
+	if err != nil {
+		return err
+	}
+	body.SetAttributeRaw("custom_data", hclwrite.TokensForFunctionCall("jsonencode", hclwrite.TokensForValue(value)))
+	return nil
+}
+
+// profileValue returns the profile attribute of a user, or a null value when
+// the profile is empty.
+func profileValue(profile *client.Profile) cty.Value {
+	if profile == nil {
+		return cty.NullVal(cty.DynamicPseudoType)
+	}
+
+	attributes := map[string]cty.Value{}
+	for name, value := range map[string]string{
+		"family_name":        profile.FamilyName,
+		"given_name":         profile.GivenName,
+		"middle_name":        profile.MiddleName,
+		"nickname":           profile.Nickname,
+		"preferred_username": profile.PreferredUsername,
+		"profile":            profile.Profile,
+		"website":            profile.Website,
+		"gender":             profile.Gender,
+		"birthdate":          profile.Birthdate,
+		"zoneinfo":           profile.Zoneinfo,
+		"locale":             profile.Locale,
+	} {
+		if value != "" {
+			attributes[name] = cty.StringVal(value)
+		}
+	}
+
+	if address := profile.Address; address != nil {
+		fields := map[string]cty.Value{}
+		for name, value := range map[string]string{
+			"formatted":      address.Formatted,
+			"street_address": address.StreetAddress,
+			"locality":       address.Locality,
+			"region":         address.Region,
+			"postal_code":    address.PostalCode,
+			"country":        address.Country,
+		} {
+			if value != "" {
+				fields[name] = cty.StringVal(value)
+			}
+		}
+		if len(fields) != 0 {
+			attributes["address"] = cty.ObjectVal(fields)
+		}
+	}
+
+	if len(attributes) == 0 {
+		return cty.NullVal(cty.DynamicPseudoType)
+	}
+	return cty.ObjectVal(attributes)
+}
@@ -0,0 +1,121 @@
+package export
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/Lenstra/terraform-provider-logto/client"
+	"github.com/Lenstra/terraform-provider-logto/client/logtotest"
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclwrite"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGenerate(t *testing.T) {
+	ctx := context.Background()
+
+	server := logtotest.NewServer()
+	t.Cleanup(server.Close)
+
+	c, err := client.NewClient(&client.Config{
+		Hostname:          server.Hostname(),
+		ApplicationID:     server.ApplicationID,
+		ApplicationSecret: server.ApplicationSecret,
+		HttpClient:        server.Client(),
+	})
+	require.NoError(t, err)
+
+	api, err := c.ApiResourceCreate(ctx, &client.ApiResourceModel{
+		Name:      "Orders API",
+		Indicator: "https://api.example.com",
+	})
+	require.NoError(t, err)
+	scope, err := c.ApiResourceScopeCreate(ctx, api.ID, &client.ScopeModel{
+		Name:        "read:orders",
+		Description: "Read the orders",
+	})
+	require.NoError(t, err)
+
+	userRole, err := c.RoleCreate(ctx, &client.RoleModel{
+		Name:        "Support",
+		Description: "Support team",
+		ScopeIds:    []string{scope.ID},
+	})
+	require.NoError(t, err)
+	appRole, err := c.RoleCreate(ctx, &client.RoleModel{
+		Name:        "Billing",
+		Description: "Billing service",
+		Type:        "MachineToMachine",
+	})
+	require.NoError(t, err)
+
+	user, err := c.UserCreate(ctx, &client.UserModel{
+		Username:   "jdoe",
+		Name:       "John Doe",
+		CustomData: json.RawMessage(`{"team":"support"}`),
+	})
+	require.NoError(t, err)
+	require.NoError(t, c.AssignRolesForUser(ctx, &client.RoleIdsModel{RoleIds: []string{userRole.ID}}, user.ID))
+
+	// Both users must get a different name.
+	phone := "33612345678"
+	_, err = c.UserCreate(ctx, &client.UserModel{Username: "j.doe", PrimaryPhone: &phone})
+	require.NoError(t, err)
+
+	app, err := c.ApplicationCreate(ctx, &client.ApplicationModel{
+		Name: "Billing service",
+		Type: "MachineToMachine",
+	})
+	require.NoError(t, err)
+	require.NoError(t, c.AssignRolesForApplication(ctx, &client.RoleIdsModel{RoleIds: []string{appRole.ID}}, app.ID))
+
+	files, err := Generate(ctx, c)
+	require.NoError(t, err)
+	require.ElementsMatch(t, []string{"api_resources.tf", "roles.tf", "users.tf", "applications.tf"}, keys(files))
+
+	for name, content := range files {
+		_, diags := hclwrite.ParseConfig(content, name, hcl.InitialPos)
+		require.False(t, diags.HasErrors(), diags.Error())
+	}
+
+	require.Contains(t, string(files["api_resources.tf"]), `resource "logto_api_resource_scope" "orders_api_read_orders" {
+  name        = "read:orders"
+  resource_id = logto_api_resource.orders_api.id
+  description = "Read the orders"
+}
+
+import {
+  to = logto_api_resource_scope.orders_api_read_orders
+  id = "`+api.ID+"/"+scope.ID+`"
+}`)
+	require.Contains(t, string(files["roles.tf"]), `scope_ids   = [logto_api_resource_scope.orders_api_read_orders.id]`)
+	require.Contains(t, string(files["users.tf"]), `resource "logto_user" "jdoe" {`)
+	require.Contains(t, string(files["users.tf"]), `resource "logto_user" "j_doe" {`)
+	require.Contains(t, string(files["users.tf"]), `"+33612345678"`)
+	require.NotContains(t, string(files["users.tf"]), `"++`)
+	require.Contains(t, string(files["users.tf"]), `custom_data = jsonencode({
+    team = "support"
+  })`)
+	require.Contains(t, string(files["users.tf"]), `role_ids = [logto_role.support.id]`)
+	require.Contains(t, string(files["applications.tf"]), `role_ids = [logto_role.billing.id]`)
+
+	dir := t.TempDir()
+	require.NoError(t, Write(dir, files))
+	content, err := os.ReadFile(filepath.Join(dir, "roles.tf"))
+	require.NoError(t, err)
+	require.Equal(t, files["roles.tf"], content)
+
+	// The files written by a previous export are not overwritten.
+	require.ErrorContains(t, Write(dir, files), "already exists")
+}
+
+func keys(files map[string][]byte) []string {
+	var names []string
+	for name := range files {
+		names = append(names, name)
+	}
+	return names
+}
@@ -0,0 +1,159 @@
+// Package customdata implements the custom_data and custom_data_keys
+// attributes of the resources whose Logto objects hold custom data.
+package customdata
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Expand returns the custom data to send to Logto, or nil when it must be left
+// untouched. When keys is not null only the listed keys are managed: the
+// other keys of current are kept as is and the listed keys missing from data
+// are removed.
+func Expand(ctx context.Context, data jsontypes.Normalized, keys types.Set, current json.RawMessage) (json.RawMessage, diag.Diagnostics) {
+	if data.IsNull() || data.IsUnknown() {
+		return nil, nil
+	}
+
+	var managed map[string]any
+	if err := unmarshal([]byte(data.ValueString()), &managed); err != nil || managed == nil {
+		var diags diag.Diagnostics
+		diags.AddAttributeError(
+			path.Root("custom_data"),
+			"Invalid custom_data",
+			"custom_data must be a JSON encoded object.",
+		)
+		return nil, diags
+	}
+
+	if keys.IsNull() {
+		return json.RawMessage(data.ValueString()), nil
+	}
+
+	names, diags := keyNames(ctx, keys)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	res, diags := decode(current)
+	if diags.HasError() {
+		return nil, diags
+	}
+	for _, name := range names {
+		delete(res, name)
+	}
+	for name, value := range managed {
+		res[name] = value
+	}
+
+	content, err := json.Marshal(res)
+	if err != nil {
+		diags.AddError("Error encoding custom_data", err.Error())
+		return nil, diags
+	}
+	return content, diags
+}
+
+// Flatten returns the custom data to save in the state. It only contains the
+// keys listed in keys unless it is null.
+func Flatten(ctx context.Context, current json.RawMessage, keys types.Set) (jsontypes.Normalized, diag.Diagnostics) {
+	data, diags := decode(current)
+	if diags.HasError() {
+		return jsontypes.NewNormalizedNull(), diags
+	}
+
+	if !keys.IsNull() && !keys.IsUnknown() {
+		names, d := keyNames(ctx, keys)
+		diags.Append(d...)
+		if diags.HasError() {
+			return jsontypes.NewNormalizedNull(), diags
+		}
+		for name := range data {
+			if !slices.Contains(names, name) {
+				delete(data, name)
+			}
+		}
+	}
+
+	// Re-encode the custom data so that the state does not depend on the
+	// formatting used by Logto.
+	content, err := json.Marshal(data)
+	if err != nil {
+		diags.AddError("Error encoding custom_data", err.Error())
+		return jsontypes.NewNormalizedNull(), diags
+	}
+	return jsontypes.NewNormalizedValue(string(content)), diags
+}
+
+// Validate makes sure that all the keys of data are listed in keys when only
+// some keys are managed, as the others would be missing from the state.
+func Validate(ctx context.Context, data jsontypes.Normalized, keys types.Set) diag.Diagnostics {
+	if keys.IsNull() || keys.IsUnknown() || data.IsNull() || data.IsUnknown() {
+		return nil
+	}
+
+	var managed map[string]any
+	if err := unmarshal([]byte(data.ValueString()), &managed); err != nil {
+		// The value itself is validated by its type.
+		return nil
+	}
+
+	names, diags := keyNames(ctx, keys)
+	if diags.HasError() {
+		return diags
+	}
+	for name := range managed {
+		if !slices.Contains(names, name) {
+			diags.AddAttributeError(
+				path.Root("custom_data"),
+				"Invalid custom_data",
+				fmt.Sprintf("The key %q must be listed in custom_data_keys to be managed.", name),
+			)
+		}
+	}
+	return diags
+}
+
+func keyNames(ctx context.Context, keys types.Set) ([]string, diag.Diagnostics) {
+	var names []string
+	diags := keys.ElementsAs(ctx, &names, false)
+	return names, diags
+}
+
+func decode(content json.RawMessage) (map[string]any, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	data := map[string]any{}
+	if len(content) == 0 || string(content) == "null" {
+		return data, diags
+	}
+	if err := unmarshal(content, &data); err != nil {
+		diags.AddError("Error decoding custom_data", err.Error())
+	}
+	return data, diags
+}
+
+// unmarshal is json.Unmarshal keeping the numbers as json.Number, so that the
+// integers too large for a float64 are encoded again without being rounded.
+func unmarshal(content []byte, v any) error {
+	decoder := json.NewDecoder(bytes.NewReader(content))
+	decoder.UseNumber()
+	if err := decoder.Decode(v); err != nil {
+		return err
+	}
+	if _, err := decoder.Token(); !errors.Is(err, io.EOF) {
+		return errors.New("invalid data after the JSON value")
+	}
+	return nil
+}
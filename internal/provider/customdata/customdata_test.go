@@ -0,0 +1,45 @@
+package customdata
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLargeIntegers(t *testing.T) {
+	ctx := context.Background()
+
+	keys := types.SetValueMust(types.StringType, []attr.Value{types.StringValue("id")})
+	data := jsontypes.NewNormalizedValue(`{"id":9007199254740993}`)
+	current := json.RawMessage(`{"id":1,"createdAt":1234567890123456789}`)
+
+	require.False(t, Validate(ctx, data, keys).HasError())
+
+	expanded, diags := Expand(ctx, data, keys, current)
+	require.False(t, diags.HasError())
+	require.JSONEq(t, `{"id":9007199254740993,"createdAt":1234567890123456789}`, string(expanded))
+	require.Contains(t, string(expanded), `9007199254740993`)
+	require.Contains(t, string(expanded), `1234567890123456789`)
+
+	flattened, diags := Flatten(ctx, expanded, types.SetNull(types.StringType))
+	require.False(t, diags.HasError())
+	require.Equal(t, `{"createdAt":1234567890123456789,"id":9007199254740993}`, flattened.ValueString())
+
+	flattened, diags = Flatten(ctx, expanded, keys)
+	require.False(t, diags.HasError())
+	require.Equal(t, `{"id":9007199254740993}`, flattened.ValueString())
+}
+
+func TestInvalidCustomData(t *testing.T) {
+	ctx := context.Background()
+
+	for _, value := range []string{`[1]`, `{"id":1} {}`, `null`} {
+		_, diags := Expand(ctx, jsontypes.NewNormalizedValue(value), types.SetNull(types.StringType), nil)
+		require.True(t, diags.HasError(), value)
+	}
+}
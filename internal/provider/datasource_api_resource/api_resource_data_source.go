@@ -0,0 +1,70 @@
+package datasource_api_resource
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/Lenstra/terraform-provider-logto/client"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func (d *apiResourceDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config ApiResourceModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var apiResource *client.ApiResourceModel
+	var err error
+	if !config.Id.IsNull() {
+		apiResource, err = d.client.ApiResourceGet(ctx, config.Id.ValueString())
+	} else {
+		apiResource, err = d.findByIndicator(ctx, config.Indicator.ValueString())
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading API resource", err.Error())
+		return
+	}
+
+	if apiResource == nil {
+		resp.Diagnostics.AddError("API resource not found", "No API resource matches the given id or indicator.")
+		return
+	}
+
+	diags = resp.State.Set(ctx, convertToTerraformModel(apiResource))
+	resp.Diagnostics.Append(diags...)
+}
+
+// findByIndicator returns the API resource with the given indicator, or nil
+// if there is none. Logto cannot search API resources so all of them are
+// listed.
+func (d *apiResourceDataSource) findByIndicator(ctx context.Context, indicator string) (*client.ApiResourceModel, error) {
+	for apiResource, err := range d.client.ApiResourcesAll(ctx, nil) {
+		if err != nil {
+			return nil, err
+		}
+		if apiResource.Indicator == indicator {
+			return &apiResource, nil
+		}
+	}
+	return nil, nil
+}
+
+func convertToTerraformModel(apiResource *client.ApiResourceModel) ApiResourceModel {
+	model := ApiResourceModel{
+		Id:             types.StringValue(apiResource.ID),
+		Name:           types.StringValue(apiResource.Name),
+		Indicator:      types.StringValue(apiResource.Indicator),
+		IsDefault:      types.BoolPointerValue(apiResource.IsDefault),
+		AccessTokenTtl: types.NumberNull(),
+	}
+
+	if apiResource.AccessTokenTtl != nil {
+		model.AccessTokenTtl = types.NumberValue(big.NewFloat(*apiResource.AccessTokenTtl))
+	}
+
+	return model
+}
@@ -0,0 +1,59 @@
+package datasource_api_resource_scope
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/Lenstra/terraform-provider-logto/client"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func (d *apiResourceScopeDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config ApiResourceScopeModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Logto has no endpoint to get a single scope so both lookups go
+	// through the scopes of the API resource.
+	queryParams := map[string]string{}
+	match := func(scope client.ScopeModel) bool { return scope.ID == config.Id.ValueString() }
+	if config.Id.IsNull() {
+		queryParams["search"] = config.Name.ValueString()
+		match = func(scope client.ScopeModel) bool { return scope.Name == config.Name.ValueString() }
+	}
+
+	for scope, err := range d.client.ApiResourceScopesAll(ctx, config.ResourceId.ValueString(), queryParams) {
+		if err != nil {
+			resp.Diagnostics.AddError("Error reading API resource scopes", err.Error())
+			return
+		}
+		if match(scope) {
+			diags = resp.State.Set(ctx, convertToTerraformModel(config.ResourceId.ValueString(), &scope))
+			resp.Diagnostics.Append(diags...)
+			return
+		}
+	}
+
+	resp.Diagnostics.AddError("API resource scope not found", "No scope of the API resource matches the given id or name.")
+}
+
+func convertToTerraformModel(resourceId string, scope *client.ScopeModel) ApiResourceScopeModel {
+	model := ApiResourceScopeModel{
+		Id:          types.StringValue(scope.ID),
+		Name:        types.StringValue(scope.Name),
+		Description: types.StringValue(scope.Description),
+		ResourceId:  types.StringValue(resourceId),
+		TenantId:    types.StringValue(scope.TenantId),
+		CreatedAt:   types.NumberNull(),
+	}
+
+	if scope.CreatedAt != nil {
+		model.CreatedAt = types.NumberValue(big.NewFloat(*scope.CreatedAt))
+	}
+
+	return model
+}
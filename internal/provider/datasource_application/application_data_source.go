@@ -0,0 +1,126 @@
+package datasource_application
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Lenstra/terraform-provider-logto/client"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+func (d *applicationDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config ApplicationModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var app *client.ApplicationModel
+	var err error
+	if !config.Id.IsNull() {
+		app, err = d.client.ApplicationGet(ctx, config.Id.ValueString())
+	} else {
+		app, err = d.findByName(ctx, config.Name.ValueString())
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading application", err.Error())
+		return
+	}
+
+	if app == nil {
+		resp.Diagnostics.AddError("Application not found", "No application matches the given id or name.")
+		return
+	}
+
+	roleIds := &client.RoleIdsModel{RoleIds: []string{}}
+	if app.Type == "MachineToMachine" {
+		roles, err := d.client.GetRolesForApplication(ctx, app.ID)
+		if err != nil {
+			resp.Diagnostics.AddError("Error reading roles of application", err.Error())
+			return
+		}
+		for _, role := range roles {
+			roleIds.RoleIds = append(roleIds.RoleIds, role.ID)
+		}
+	}
+
+	var state ApplicationModel
+	diags = convertToTerraformModel(ctx, app, roleIds, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// findByName returns the application called name, or nil if there is none.
+// Logto does not enforce unique application names so an error is returned
+// when the name is ambiguous.
+func (d *applicationDataSource) findByName(ctx context.Context, name string) (*client.ApplicationModel, error) {
+	var found *client.ApplicationModel
+	for app, err := range d.client.ApplicationsAll(ctx, map[string]string{"search": name}) {
+		if err != nil {
+			return nil, err
+		}
+		if app.Name != name {
+			continue
+		}
+		if found != nil {
+			return nil, fmt.Errorf("multiple applications are named %q, use the id instead", name)
+		}
+		found = &app
+	}
+	return found, nil
+}
+
+func convertToTerraformModel(ctx context.Context, app *client.ApplicationModel, roleIds *client.RoleIdsModel, model *ApplicationModel) (diags diag.Diagnostics) {
+	*model = ApplicationModel{
+		Id:           types.StringValue(app.ID),
+		TenantId:     types.StringValue(app.TenantId),
+		Name:         types.StringValue(app.Name),
+		Description:  types.StringValue(app.Description),
+		Type:         types.StringValue(app.Type),
+		IsThirdParty: types.BoolValue(app.IsThirdParty),
+		IsAdmin:      types.BoolValue(app.IsAdmin),
+	}
+
+	model.RoleIds, diags = types.SetValueFrom(ctx, types.StringType, roleIds.RoleIds)
+	if diags.HasError() {
+		return
+	}
+
+	var redirectUris, postLogoutRedirectUris []string
+	if app.OidcClientMetadata != nil {
+		redirectUris = app.OidcClientMetadata.RedirectUris
+		postLogoutRedirectUris = app.OidcClientMetadata.PostLogoutRedirectUris
+	}
+	model.RedirectUris, diags = convertList(ctx, types.StringType, redirectUris)
+	if diags.HasError() {
+		return
+	}
+	model.PostLogoutRedirectUris, diags = convertList(ctx, types.StringType, postLogoutRedirectUris)
+	if diags.HasError() {
+		return
+	}
+
+	var corsAllowedOrigins []string
+	if app.CustomClientMetadata != nil {
+		corsAllowedOrigins = app.CustomClientMetadata.CorsAllowedOrigins
+	}
+	model.CorsAllowedOrigins, diags = convertList(ctx, types.StringType, corsAllowedOrigins)
+	return
+}
+
+func convertList[E any](ctx context.Context, elementType attr.Type, list []E) (basetypes.ListValue, diag.Diagnostics) {
+	if len(list) == 0 {
+		return basetypes.NewListValueFrom(ctx, elementType, []attr.Value{})
+	}
+	return basetypes.NewListValueFrom(ctx, elementType, list)
+}
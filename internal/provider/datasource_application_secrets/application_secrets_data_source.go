@@ -0,0 +1,77 @@
+package datasource_application_secrets
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/Lenstra/terraform-provider-logto/client"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func (d *applicationSecretsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config ApplicationSecretsModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	applicationId := config.ApplicationId.ValueString()
+	secrets, err := d.client.GetApplicationSecrets(ctx, applicationId)
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading application secrets", err.Error())
+		return
+	}
+
+	if secrets == nil {
+		resp.Diagnostics.AddError(
+			"Application not found",
+			fmt.Sprintf("No application with ID %q exists.", applicationId),
+		)
+		return
+	}
+
+	model, diags := convertToTerraformModel(ctx, applicationId, secrets)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, model)
+	resp.Diagnostics.Append(diags...)
+}
+
+func convertToTerraformModel(ctx context.Context, applicationId string, secrets []client.Secret) (ApplicationSecretsModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	values := make([]attr.Value, 0, len(secrets))
+	for _, secret := range secrets {
+		value := SecretsValue{
+			CreatedAt: types.NumberNull(),
+			ExpiresAt: types.NumberNull(),
+			Name:      types.StringValue(secret.Name),
+			Value:     types.StringValue(secret.Value),
+			state:     attr.ValueStateKnown,
+		}
+		if secret.CreatedAt != nil {
+			value.CreatedAt = types.NumberValue(big.NewFloat(*secret.CreatedAt))
+		}
+		if secret.ExpiresAt != nil {
+			value.ExpiresAt = types.NumberValue(big.NewFloat(*secret.ExpiresAt))
+		}
+
+		values = append(values, value)
+	}
+
+	list, d := types.ListValue(SecretsValue{}.Type(ctx), values)
+	diags.Append(d...)
+
+	return ApplicationSecretsModel{
+		ApplicationId: types.StringValue(applicationId),
+		Secrets:       list,
+	}, diags
+}
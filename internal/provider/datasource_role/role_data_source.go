@@ -0,0 +1,83 @@
+package datasource_role
+
+import (
+	"context"
+
+	"github.com/Lenstra/terraform-provider-logto/client"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func (d *roleDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config RoleModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var role *client.RoleModel
+	var err error
+	if !config.Id.IsNull() {
+		role, err = d.client.RoleGet(ctx, config.Id.ValueString())
+	} else {
+		role, err = d.findByName(ctx, config.Name.ValueString())
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading role", err.Error())
+		return
+	}
+
+	if role == nil {
+		resp.Diagnostics.AddError("Role not found", "No role matches the given id or name.")
+		return
+	}
+
+	scopes, err := d.client.RoleScopesGet(ctx, role.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading scopes of role", err.Error())
+		return
+	}
+
+	scopeIds := make([]string, 0, len(scopes))
+	for _, scope := range scopes {
+		scopeIds = append(scopeIds, scope.ID)
+	}
+
+	var state RoleModel
+	diags = convertToTerraformModel(ctx, role, scopeIds, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// findByName returns the role called name, or nil if there is none.
+func (d *roleDataSource) findByName(ctx context.Context, name string) (*client.RoleModel, error) {
+	for role, err := range d.client.RolesAll(ctx, map[string]string{"search": name}) {
+		if err != nil {
+			return nil, err
+		}
+		if role.Name == name {
+			return &role, nil
+		}
+	}
+	return nil, nil
+}
+
+func convertToTerraformModel(ctx context.Context, role *client.RoleModel, scopeIds []string, model *RoleModel) (diags diag.Diagnostics) {
+	*model = RoleModel{
+		Id:          types.StringValue(role.ID),
+		Name:        types.StringValue(role.Name),
+		Description: types.StringValue(role.Description),
+		Type:        types.StringValue(role.Type),
+		IsDefault:   types.BoolValue(role.IsDefault),
+	}
+
+	model.ScopeIds, diags = types.ListValueFrom(ctx, types.StringType, scopeIds)
+	return
+}
@@ -0,0 +1,148 @@
+package datasource_user
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/Lenstra/terraform-provider-logto/client"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func (d *userDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config UserModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var user *client.UserModel
+	var err error
+	switch {
+	case !config.Id.IsNull():
+		user, err = d.client.UserGetWithSsoIdentities(ctx, config.Id.ValueString())
+	case !config.Username.IsNull():
+		user, err = d.find(ctx, config.Username.ValueString(), func(u client.UserModel) string { return u.Username })
+	default:
+		user, err = d.find(ctx, config.PrimaryEmail.ValueString(), func(u client.UserModel) string { return u.PrimaryEmail })
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading user", err.Error())
+		return
+	}
+
+	if user == nil {
+		resp.Diagnostics.AddError("User not found", "No user matches the given id, username or primary email.")
+		return
+	}
+
+	// The users returned by the search do not include their SSO identities.
+	if config.Id.IsNull() {
+		user, err = d.client.UserGetWithSsoIdentities(ctx, user.ID)
+		if err != nil {
+			resp.Diagnostics.AddError("Error reading user", err.Error())
+			return
+		}
+		if user == nil {
+			resp.Diagnostics.AddError("User not found", "The user was deleted while it was being read.")
+			return
+		}
+	}
+
+	roles, err := d.client.GetRolesForUser(ctx, user.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading roles of user", err.Error())
+		return
+	}
+
+	roleIds := make([]string, 0, len(roles))
+	for _, role := range roles {
+		roleIds = append(roleIds, role.ID)
+	}
+
+	var state UserModel
+	diags = convertToTerraformModel(ctx, user, roleIds, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// find returns the user whose field, as returned by key, equals value. Both
+// usernames and primary emails are unique in Logto.
+func (d *userDataSource) find(ctx context.Context, value string, key func(client.UserModel) string) (*client.UserModel, error) {
+	for user, err := range d.client.UsersAll(ctx, map[string]string{"search": value}) {
+		if err != nil {
+			return nil, err
+		}
+		if key(user) == value {
+			return &user, nil
+		}
+	}
+	return nil, nil
+}
+
+func convertToTerraformModel(ctx context.Context, user *client.UserModel, roleIds []string, model *UserModel) (diags diag.Diagnostics) {
+	*model = UserModel{
+		Id:           types.StringValue(user.ID),
+		PrimaryEmail: types.StringValue(user.PrimaryEmail),
+		Username:     types.StringValue(user.Username),
+		Name:         types.StringValue(user.Name),
+		Profile:      NewProfileValueNull(),
+	}
+
+	if user.Profile != nil {
+		model.Profile = ProfileValue{
+			FamilyName: types.StringValue(user.Profile.FamilyName),
+			GivenName:  types.StringValue(user.Profile.GivenName),
+			MiddleName: types.StringValue(user.Profile.MiddleName),
+			Nickname:   types.StringValue(user.Profile.Nickname),
+			state:      attr.ValueStateKnown,
+		}
+	}
+
+	identities := make(map[string]attr.Value, len(user.Identities))
+	for target, identity := range user.Identities {
+		identities[target] = IdentitiesValue{
+			Details:    jsonValue(identity.Details),
+			IdentityId: types.StringValue(identity.UserID),
+			state:      attr.ValueStateKnown,
+		}
+	}
+	model.Identities, diags = types.MapValue(IdentitiesValue{}.Type(ctx), identities)
+	if diags.HasError() {
+		return
+	}
+
+	ssoIdentities := make([]attr.Value, 0, len(user.SsoIdentities))
+	for _, identity := range user.SsoIdentities {
+		ssoIdentities = append(ssoIdentities, SsoIdentitiesValue{
+			Detail:         jsonValue(identity.Detail),
+			Id:             types.StringValue(identity.ID),
+			IdentityId:     types.StringValue(identity.IdentityID),
+			Issuer:         types.StringValue(identity.Issuer),
+			SsoConnectorId: types.StringValue(identity.SsoConnectorID),
+			state:          attr.ValueStateKnown,
+		})
+	}
+	model.SsoIdentities, diags = types.ListValue(SsoIdentitiesValue{}.Type(ctx), ssoIdentities)
+	if diags.HasError() {
+		return
+	}
+
+	model.RoleIds, diags = types.SetValueFrom(ctx, types.StringType, roleIds)
+	return
+}
+
+func jsonValue(content json.RawMessage) types.String {
+	if len(content) == 0 || string(content) == "null" {
+		return types.StringNull()
+	}
+	return types.StringValue(string(content))
+}
@@ -0,0 +1,54 @@
+// Package identity implements the resource identity of the resources whose
+// Logto object is identified by its ID alone. The identity lets Terraform
+// import them using the identity attribute of import blocks, and is required
+// to list them with terraform query.
+package identity
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type Model struct {
+	Id types.String `tfsdk:"id"`
+}
+
+// Schema returns the identity schema of the resources.
+func Schema() identityschema.Schema {
+	return identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"id": identityschema.StringAttribute{
+				RequiredForImport: true,
+				Description:       "The unique identifier of the object in Logto.",
+			},
+		},
+	}
+}
+
+// Set stores id as the identity of a resource. It does nothing when the
+// resource has no identity, for example when Terraform is older than 1.12.
+func Set(ctx context.Context, identity *tfsdk.ResourceIdentity, id string) diag.Diagnostics {
+	if identity == nil {
+		return nil
+	}
+	return identity.Set(ctx, Model{Id: types.StringValue(id)})
+}
+
+// ImportID returns the identifier given to import a resource, either on the
+// command line and in the id attribute of import blocks or in their identity
+// attribute.
+func ImportID(ctx context.Context, req resource.ImportStateRequest) (string, diag.Diagnostics) {
+	if req.ID != "" || req.Identity == nil {
+		return req.ID, nil
+	}
+
+	var id types.String
+	diags := req.Identity.GetAttribute(ctx, path.Root("id"), &id)
+	return id.ValueString(), diags
+}
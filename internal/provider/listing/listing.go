@@ -0,0 +1,39 @@
+// Package listing implements the parts shared by the list resources, which
+// let terraform query discover the objects of the tenant.
+package listing
+
+import (
+	"context"
+	"iter"
+
+	"github.com/hashicorp/terraform-plugin-framework/list"
+)
+
+// Results returns the results of a list request built from the objects
+// returned by one of the pagers of the client. fill sets the identity, the
+// display name and, when req.IncludeResource is set, the resource of the
+// result of each object. The iteration stops at the first error or once the
+// number of results asked by Terraform is reached.
+func Results[T any](ctx context.Context, req list.ListRequest, objects iter.Seq2[T, error], summary string, fill func(T, *list.ListResult)) iter.Seq[list.ListResult] {
+	return func(push func(list.ListResult) bool) {
+		var count int64
+		for object, err := range objects {
+			result := req.NewListResult(ctx)
+			if err != nil {
+				result.Diagnostics.AddError(summary, err.Error())
+				push(result)
+				return
+			}
+
+			fill(object, &result)
+			if !push(result) || result.Diagnostics.HasError() {
+				return
+			}
+
+			count++
+			if req.Limit > 0 && count >= req.Limit {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,36 @@
+package provider_logto
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccApiResourceDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: ProviderConfig + `
+					resource "logto_api_resource" "test_api" {
+						name      = "tf_test_api_resource_data_source"
+						indicator = "https://tf-test-api-resource-data-source.test"
+					}
+
+					data "logto_api_resource" "by_id" {
+						id = logto_api_resource.test_api.id
+					}
+
+					data "logto_api_resource" "by_indicator" {
+						indicator = logto_api_resource.test_api.indicator
+					}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.logto_api_resource.by_id", "indicator", "logto_api_resource.test_api", "indicator"),
+					resource.TestCheckResourceAttrPair("data.logto_api_resource.by_indicator", "id", "logto_api_resource.test_api", "id"),
+					resource.TestCheckResourceAttr("data.logto_api_resource.by_indicator", "name", "tf_test_api_resource_data_source"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,53 @@
+package provider_logto
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/querycheck"
+	"github.com/hashicorp/terraform-plugin-testing/querycheck/queryfilter"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAccApiResourceListResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		ProtoV6ProviderFactories: TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: ProviderConfig + `
+					resource "logto_api_resource" "test" {
+						name      = "tf_list_api_resource"
+						indicator = "https://list-api-resources.test"
+					}
+				`,
+			},
+			{
+				Query: true,
+				Config: ProviderConfig + `
+					list "logto_api_resource" "test" {
+						provider         = logto
+						include_resource = true
+					}
+				`,
+				QueryResultChecks: []querycheck.QueryResultCheck{
+					querycheck.ExpectLengthAtLeast("logto_api_resource.test", 1),
+					querycheck.ExpectResourceKnownValues(
+						"logto_api_resource.test",
+						queryfilter.ByDisplayName(knownvalue.StringExact("tf_list_api_resource")),
+						[]querycheck.KnownValueCheck{
+							{
+								Path:       tfjsonpath.New("indicator"),
+								KnownValue: knownvalue.StringExact("https://list-api-resources.test"),
+							},
+						},
+					),
+				},
+			},
+		},
+	})
+}
@@ -0,0 +1,44 @@
+package provider_logto
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccApiResourceScopeDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: ProviderConfig + `
+					resource "logto_api_resource" "test_api" {
+						name      = "tf_test_api_resource_scope_data_source"
+						indicator = "https://tf-test-api-resource-scope-data-source.test"
+					}
+
+					resource "logto_api_resource_scope" "test_scope" {
+						resource_id = logto_api_resource.test_api.id
+						name        = "read:data_source"
+						description = "test scope"
+					}
+
+					data "logto_api_resource_scope" "by_id" {
+						resource_id = logto_api_resource.test_api.id
+						id          = logto_api_resource_scope.test_scope.id
+					}
+
+					data "logto_api_resource_scope" "by_name" {
+						resource_id = logto_api_resource.test_api.id
+						name        = logto_api_resource_scope.test_scope.name
+					}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.logto_api_resource_scope.by_id", "name", "logto_api_resource_scope.test_scope", "name"),
+					resource.TestCheckResourceAttrPair("data.logto_api_resource_scope.by_name", "id", "logto_api_resource_scope.test_scope", "id"),
+					resource.TestCheckResourceAttr("data.logto_api_resource_scope.by_name", "description", "test scope"),
+				),
+			},
+		},
+	})
+}
@@ -40,7 +40,13 @@ func TestAccApiResourceScopeResource(t *testing.T) {
 					resource.TestCheckResourceAttrSet("logto_api_resource_scope.test_api_resource_scope", "created_at"),
 				),
 			},
-			// Cannot test the import of the resource dynamically.
+			// ImportState testing by indicator and name
+			{
+				ResourceName:      "logto_api_resource_scope.test_api_resource_scope",
+				ImportState:       true,
+				ImportStateId:     "https://test-api-resource.test:tf_test_scope",
+				ImportStateVerify: true,
+			},
 			// Update and Read testing
 			{
 				Config: ProviderConfig + `
@@ -104,6 +110,8 @@ func TestAccApiResourceScopeResource(t *testing.T) {
 	})
 }
 func TestAccImportOfApiResourceScopeResource(t *testing.T) {
+	skipWithFakeServer(t)
+
 	resource.Test(t, resource.TestCase{
 		ProtoV6ProviderFactories: TestAccProtoV6ProviderFactories,
 		Steps: []resource.TestStep{
@@ -125,3 +133,37 @@ func TestAccImportOfApiResourceScopeResource(t *testing.T) {
 		},
 	})
 }
+
+func TestAccApiResourceScopeResourceManyScopes(t *testing.T) {
+	// Logto paginates the scopes of a resource, the scopes beyond the first
+	// page must not be removed from the state.
+	config := ProviderConfig + `
+		resource "logto_api_resource" "test_api_resource" {
+			name      = "tf_test_api_resource_many_scopes"
+			indicator = "https://test-api-resource-many-scopes.test"
+		}
+
+		resource "logto_api_resource_scope" "test_api_resource_scope" {
+			count       = 25
+			name        = "tf_test_scope_${count.index}"
+			resource_id = logto_api_resource.test_api_resource.id
+		}
+	`
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("logto_api_resource_scope.test_api_resource_scope.24", "name", "tf_test_scope_24"),
+				),
+			},
+			// Refreshing the state must not plan to recreate any scope
+			{
+				Config:   config,
+				PlanOnly: true,
+			},
+		},
+	})
+}
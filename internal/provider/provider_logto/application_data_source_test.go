@@ -0,0 +1,49 @@
+package provider_logto
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccApplicationDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: ProviderConfig + `
+					resource "logto_application" "test_app" {
+						name          = "tf_test_application_data_source"
+						description   = "test app"
+						type          = "Traditional"
+						redirect_uris = ["https://example.com/callback"]
+					}
+
+					data "logto_application" "by_id" {
+						id = logto_application.test_app.id
+					}
+
+					data "logto_application" "by_name" {
+						name = logto_application.test_app.name
+					}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.logto_application.by_id", "name", "logto_application.test_app", "name"),
+					resource.TestCheckResourceAttr("data.logto_application.by_id", "type", "Traditional"),
+					resource.TestCheckResourceAttr("data.logto_application.by_id", "redirect_uris.0", "https://example.com/callback"),
+					resource.TestCheckResourceAttrPair("data.logto_application.by_name", "id", "logto_application.test_app", "id"),
+					resource.TestCheckResourceAttr("data.logto_application.by_name", "description", "test app"),
+				),
+			},
+			{
+				Config: ProviderConfig + `
+					data "logto_application" "test" {
+						name = "tf_test_does_not_exist"
+					}
+				`,
+				ExpectError: regexp.MustCompile("Application not found"),
+			},
+		},
+	})
+}
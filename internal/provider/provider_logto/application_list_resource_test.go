@@ -0,0 +1,57 @@
+package provider_logto
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/querycheck"
+	"github.com/hashicorp/terraform-plugin-testing/querycheck/queryfilter"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAccApplicationListResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		ProtoV6ProviderFactories: TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: ProviderConfig + `
+					resource "logto_application" "spa" {
+						name          = "tf_list_app_spa"
+						type          = "SPA"
+						redirect_uris = ["https://list-apps.test/callback"]
+					}
+
+					resource "logto_application" "machine_to_machine" {
+						name = "tf_list_app_m2m"
+						type = "MachineToMachine"
+					}
+				`,
+			},
+			{
+				Query: true,
+				Config: ProviderConfig + `
+					list "logto_application" "test" {
+						provider = logto
+
+						config {
+							search = "tf_list_app_%"
+							type   = "SPA"
+						}
+					}
+				`,
+				QueryResultChecks: []querycheck.QueryResultCheck{
+					querycheck.ExpectLength("logto_application.test", 1),
+					querycheck.ExpectResourceDisplayName(
+						"logto_application.test",
+						queryfilter.ByDisplayName(knownvalue.StringExact("tf_list_app_spa")),
+						knownvalue.StringExact("tf_list_app_spa"),
+					),
+				},
+			},
+		},
+	})
+}
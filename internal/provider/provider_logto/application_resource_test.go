@@ -1,6 +1,7 @@
 package provider_logto
 
 import (
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
@@ -389,3 +390,315 @@ func TestAccApplicationResourceWithCorsAllowedOrigins(t *testing.T) {
 		},
 	})
 }
+
+func TestAccApplicationResourceWithRoleIds(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: ProviderConfig + `
+							resource "logto_role" "test_role" {
+								name        = "tf_test_m2m_role"
+								description = "tf_test_m2m_role_description"
+								type        = "MachineToMachine"
+							}
+
+							resource "logto_application" "test_app" {
+								name     = "test"
+								type     = "MachineToMachine"
+								role_ids = [logto_role.test_role.id]
+							}
+							`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					// Verify attributes
+					resource.TestCheckResourceAttr("logto_application.test_app", "role_ids.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair("logto_application.test_app", "role_ids.*", "logto_role.test_role", "id"),
+
+					// Verify dynamic values have any value set in the state.
+					resource.TestCheckResourceAttrSet("logto_application.test_app", "id"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "logto_application.test_app",
+				ImportState:       true,
+				ImportStateVerify: true,
+				// The roles are only tracked when they are set in the
+				// configuration.
+				ImportStateVerifyIgnore: []string{"role_ids"},
+			},
+			// Update and Read testing
+			{
+				Config: ProviderConfig + `
+							resource "logto_role" "test_role" {
+								name        = "tf_test_m2m_role"
+								description = "tf_test_m2m_role_description"
+								type        = "MachineToMachine"
+							}
+
+							resource "logto_application" "test_app" {
+								name     = "test"
+								type     = "MachineToMachine"
+								role_ids = []
+							}
+							`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					// Verify attributes
+					resource.TestCheckResourceAttr("logto_application.test_app", "role_ids.#", "0"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccApplicationResourceWithRoleIdsAndWrongType(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: ProviderConfig + `
+							resource "logto_application" "test_app" {
+								name     = "test"
+								type     = "SPA"
+								role_ids = ["role_id"]
+							}
+							`,
+				ExpectError: regexp.MustCompile("role_ids can only be set for MachineToMachine applications"),
+			},
+		},
+	})
+}
+
+func TestAccApplicationResourceWithOidcMetadata(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing with the default values
+			{
+				Config: ProviderConfig + `
+							resource "logto_application" "test_app" {
+									name = "test"
+									type = "Traditional"
+							}
+							`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckNoResourceAttr("logto_application.test_app", "backchannel_logout_uri"),
+					resource.TestCheckResourceAttr("logto_application.test_app", "backchannel_logout_session_required", "false"),
+					resource.TestCheckNoResourceAttr("logto_application.test_app", "logo_uri"),
+					resource.TestCheckResourceAttr("logto_application.test_app", "id_token_ttl", "3600"),
+					resource.TestCheckResourceAttr("logto_application.test_app", "refresh_token_ttl_in_days", "14"),
+					resource.TestCheckResourceAttr("logto_application.test_app", "always_issue_refresh_token", "false"),
+					resource.TestCheckResourceAttr("logto_application.test_app", "rotate_refresh_token", "true"),
+				),
+			},
+			// Update and Read testing
+			{
+				Config: ProviderConfig + `
+							resource "logto_application" "test_app" {
+									name                                = "test"
+									type                                = "Traditional"
+									backchannel_logout_uri              = "https://example.com/logout"
+									backchannel_logout_session_required = true
+									logo_uri                            = "https://example.com/logo.png"
+									id_token_ttl                        = 600
+									refresh_token_ttl_in_days           = 30
+									always_issue_refresh_token          = true
+									rotate_refresh_token                = false
+							}
+							`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("logto_application.test_app", "backchannel_logout_uri", "https://example.com/logout"),
+					resource.TestCheckResourceAttr("logto_application.test_app", "backchannel_logout_session_required", "true"),
+					resource.TestCheckResourceAttr("logto_application.test_app", "logo_uri", "https://example.com/logo.png"),
+					resource.TestCheckResourceAttr("logto_application.test_app", "id_token_ttl", "600"),
+					resource.TestCheckResourceAttr("logto_application.test_app", "refresh_token_ttl_in_days", "30"),
+					resource.TestCheckResourceAttr("logto_application.test_app", "always_issue_refresh_token", "true"),
+					resource.TestCheckResourceAttr("logto_application.test_app", "rotate_refresh_token", "false"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "logto_application.test_app",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Removing the attributes restores the defaults
+			{
+				Config: ProviderConfig + `
+							resource "logto_application" "test_app" {
+									name = "test"
+									type = "Traditional"
+							}
+							`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckNoResourceAttr("logto_application.test_app", "backchannel_logout_uri"),
+					resource.TestCheckNoResourceAttr("logto_application.test_app", "logo_uri"),
+					resource.TestCheckResourceAttr("logto_application.test_app", "id_token_ttl", "3600"),
+					resource.TestCheckResourceAttr("logto_application.test_app", "rotate_refresh_token", "true"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccApplicationResourceWithInvalidOidcMetadata(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: ProviderConfig + `
+							resource "logto_application" "test_app" {
+									name                      = "test"
+									type                      = "Traditional"
+									refresh_token_ttl_in_days = 365
+							}
+							`,
+				ExpectError: regexp.MustCompile("refresh_token_ttl_in_days"),
+			},
+			{
+				Config: ProviderConfig + `
+							resource "logto_application" "test_app" {
+									name     = "test"
+									type     = "Traditional"
+									logo_uri = "logo.png"
+							}
+							`,
+				ExpectError: regexp.MustCompile("must be an http or https URL"),
+			},
+		},
+	})
+}
+
+func TestAccApplicationResourceProtectedApp(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: ProviderConfig + `
+							resource "logto_application" "test_app" {
+									name = "test"
+									type = "Protected"
+
+									protected_app = {
+										sub_domain = "terraform-provider-test"
+										origin     = "https://example.com"
+									}
+							}
+							`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("logto_application.test_app", "protected_app.host"),
+					resource.TestCheckResourceAttr("logto_application.test_app", "protected_app.sub_domain", "terraform-provider-test"),
+					resource.TestCheckResourceAttr("logto_application.test_app", "protected_app.origin", "https://example.com"),
+					resource.TestCheckResourceAttr("logto_application.test_app", "protected_app.session_duration", "1209600"),
+					resource.TestCheckResourceAttr("logto_application.test_app", "protected_app.page_rules.#", "0"),
+					resource.TestCheckResourceAttr("logto_application.test_app", "protected_app.custom_domains.#", "0"),
+					resource.TestCheckResourceAttr("logto_application.test_app", "redirect_uris.#", "1"),
+				),
+			},
+			// Update and Read testing
+			{
+				Config: ProviderConfig + `
+							resource "logto_application" "test_app" {
+									name = "test"
+									type = "Protected"
+
+									protected_app = {
+										sub_domain       = "terraform-provider-test"
+										origin           = "https://example.org"
+										session_duration = 3600
+										page_rules       = ["^/admin"]
+										custom_domains   = ["auth.example.org"]
+									}
+							}
+							`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("logto_application.test_app", "protected_app.origin", "https://example.org"),
+					resource.TestCheckResourceAttr("logto_application.test_app", "protected_app.session_duration", "3600"),
+					resource.TestCheckResourceAttr("logto_application.test_app", "protected_app.page_rules.0", "^/admin"),
+					resource.TestCheckTypeSetElemAttr("logto_application.test_app", "protected_app.custom_domains.*", "auth.example.org"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "logto_application.test_app",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccApplicationResourceInvalidProtectedApp(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: ProviderConfig + `
+							resource "logto_application" "test_app" {
+									name = "test"
+									type = "Traditional"
+
+									protected_app = {
+										sub_domain = "terraform-provider-test"
+										origin     = "https://example.com"
+									}
+							}
+							`,
+				ExpectError: regexp.MustCompile("protected_app can only be set for Protected applications"),
+			},
+			{
+				Config: ProviderConfig + `
+							resource "logto_application" "test_app" {
+									name = "test"
+									type = "Protected"
+							}
+							`,
+				ExpectError: regexp.MustCompile("protected_app must be set for Protected applications"),
+			},
+		},
+	})
+}
+
+func TestAccApplicationResourceWithCustomData(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: ProviderConfig + `
+							resource "logto_application" "test_app" {
+									name        = "test"
+									type        = "SPA"
+									custom_data = "{\"plan\": \"free\", \"features\": [\"beta\"]}"
+							}
+							`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("logto_application.test_app", "custom_data", `{"plan": "free", "features": ["beta"]}`),
+				),
+			},
+			// The order of the keys does not cause a diff
+			{
+				Config: ProviderConfig + `
+							resource "logto_application" "test_app" {
+									name        = "test"
+									type        = "SPA"
+									custom_data = jsonencode({
+										features = ["beta"]
+										plan     = "free"
+									})
+							}
+							`,
+				PlanOnly: true,
+			},
+			// ImportState testing
+			{
+				ResourceName:      "logto_application.test_app",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
@@ -0,0 +1,125 @@
+package provider_logto
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+const applicationSecretConfig = `
+	resource "logto_application" "test_app" {
+		name = "tf_test_application_secret"
+		type = "MachineToMachine"
+	}
+`
+
+func TestAccApplicationSecretResource(t *testing.T) {
+	var value string
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: ProviderConfig + applicationSecretConfig + `
+					resource "logto_application_secret" "test_secret" {
+						application_id = logto_application.test_app.id
+						name           = "tf_test_secret"
+						expires_at     = 4102444800000
+
+						keepers = {
+							rotation = "1"
+						}
+					}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					// Verify attributes
+					resource.TestCheckResourceAttrPair("logto_application_secret.test_secret", "application_id", "logto_application.test_app", "id"),
+					resource.TestCheckResourceAttr("logto_application_secret.test_secret", "name", "tf_test_secret"),
+					resource.TestCheckResourceAttr("logto_application_secret.test_secret", "expires_at", "4102444800000"),
+
+					// Verify dynamic values have any value set in the state.
+					resource.TestCheckResourceAttrSet("logto_application_secret.test_secret", "id"),
+					resource.TestCheckResourceAttrSet("logto_application_secret.test_secret", "created_at"),
+					resource.TestCheckResourceAttrWith("logto_application_secret.test_secret", "value", func(v string) error {
+						value = v
+						if v == "" {
+							return fmt.Errorf("expected a secret value")
+						}
+						return nil
+					}),
+				),
+			},
+			// Renaming keeps the secret
+			{
+				Config: ProviderConfig + applicationSecretConfig + `
+					resource "logto_application_secret" "test_secret" {
+						application_id = logto_application.test_app.id
+						name           = "tf_test_secret_renamed"
+						expires_at     = 4102444800000
+
+						keepers = {
+							rotation = "1"
+						}
+					}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("logto_application_secret.test_secret", "name", "tf_test_secret_renamed"),
+					resource.TestCheckResourceAttrWith("logto_application_secret.test_secret", "id", func(v string) error {
+						if !strings.HasSuffix(v, "/tf_test_secret_renamed") {
+							return fmt.Errorf("expected the identifier to hold the new name, got %q", v)
+						}
+						return nil
+					}),
+					resource.TestCheckResourceAttrWith("logto_application_secret.test_secret", "value", func(v string) error {
+						if v != value {
+							return fmt.Errorf("expected the secret to be kept on rename")
+						}
+						return nil
+					}),
+				),
+			},
+			// Changing the keepers rotates the secret
+			{
+				Config: ProviderConfig + applicationSecretConfig + `
+					resource "logto_application_secret" "test_secret" {
+						application_id = logto_application.test_app.id
+						name           = "tf_test_secret_renamed"
+						expires_at     = 4102444800000
+
+						keepers = {
+							rotation = "2"
+						}
+					}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("logto_application_secret.test_secret", "keepers.rotation", "2"),
+					resource.TestCheckResourceAttrWith("logto_application_secret.test_secret", "value", func(v string) error {
+						if v == value {
+							return fmt.Errorf("expected the secret to be rotated")
+						}
+						return nil
+					}),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:            "logto_application_secret.test_secret",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"keepers"},
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					rs, ok := s.RootModule().Resources["logto_application_secret.test_secret"]
+					if !ok {
+						return "", fmt.Errorf("resource not found in state")
+					}
+					return fmt.Sprintf("%s/%s", rs.Primary.Attributes["application_id"], rs.Primary.Attributes["name"]), nil
+				},
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
@@ -0,0 +1,36 @@
+package provider_logto
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccApplicationSecretsDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: ProviderConfig + applicationSecretConfig + `
+					resource "logto_application_secret" "test_secret" {
+						application_id = logto_application.test_app.id
+						name           = "tf_test_secret"
+					}
+
+					data "logto_application_secrets" "test" {
+						application_id = logto_application.test_app.id
+
+						depends_on = [logto_application_secret.test_secret]
+					}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.logto_application_secrets.test", "application_id", "logto_application.test_app", "id"),
+					resource.TestCheckTypeSetElemNestedAttrs("data.logto_application_secrets.test", "secrets.*", map[string]string{
+						"name": "tf_test_secret",
+					}),
+					resource.TestCheckTypeSetElemAttrPair("data.logto_application_secrets.test", "secrets.*.value", "logto_application_secret.test_secret", "value"),
+				),
+			},
+		},
+	})
+}
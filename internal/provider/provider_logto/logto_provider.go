@@ -2,17 +2,37 @@ package provider_logto
 
 import (
 	"context"
+	"math"
+	"net/http"
 	"os"
+	"strconv"
+	"time"
 
+	"github.com/Lenstra/terraform-provider-logto/internal/provider/datasource_api_resource"
+	"github.com/Lenstra/terraform-provider-logto/internal/provider/datasource_api_resource_scope"
+	"github.com/Lenstra/terraform-provider-logto/internal/provider/datasource_application"
+	"github.com/Lenstra/terraform-provider-logto/internal/provider/datasource_application_secrets"
+	"github.com/Lenstra/terraform-provider-logto/internal/provider/datasource_role"
+	"github.com/Lenstra/terraform-provider-logto/internal/provider/datasource_user"
 	"github.com/Lenstra/terraform-provider-logto/internal/provider/resource_api_resource"
 	"github.com/Lenstra/terraform-provider-logto/internal/provider/resource_api_resource_scope"
 	"github.com/Lenstra/terraform-provider-logto/internal/provider/resource_application"
+	"github.com/Lenstra/terraform-provider-logto/internal/provider/resource_application_secret"
+	"github.com/Lenstra/terraform-provider-logto/internal/provider/resource_organization"
+	"github.com/Lenstra/terraform-provider-logto/internal/provider/resource_organization_membership"
+	"github.com/Lenstra/terraform-provider-logto/internal/provider/resource_organization_role"
+	"github.com/Lenstra/terraform-provider-logto/internal/provider/resource_organization_scope"
 	"github.com/Lenstra/terraform-provider-logto/internal/provider/resource_role"
+	"github.com/Lenstra/terraform-provider-logto/internal/provider/resource_role_assignment"
+	"github.com/Lenstra/terraform-provider-logto/internal/provider/resource_role_scopes"
 	"github.com/Lenstra/terraform-provider-logto/internal/provider/resource_user"
+	"github.com/Lenstra/terraform-provider-logto/internal/provider/resource_user_identity"
+	"github.com/Lenstra/terraform-provider-logto/internal/provider/resource_user_roles"
 	"github.com/rs/zerolog"
 
 	"github.com/Lenstra/terraform-provider-logto/client"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/list"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -21,7 +41,8 @@ import (
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ provider.Provider = &logtoProvider{}
+	_ provider.Provider                  = &logtoProvider{}
+	_ provider.ProviderWithListResources = &logtoProvider{}
 )
 
 // New is a helper function to simplify provider server and testing implementation.
@@ -39,6 +60,10 @@ type logtoProvider struct {
 	// provider is built and ran locally, and "test" when running acceptance
 	// testing.
 	version string
+
+	// httpClient is the HTTP client used to reach Logto, the default client
+	// of the Logto API client is used when it is nil.
+	httpClient *http.Client
 }
 
 // Metadata returns the provider type name.
@@ -96,6 +121,70 @@ func (p *logtoProvider) Configure(ctx context.Context, req provider.ConfigureReq
 				"Either target apply the source of the value first, set the value statically in the configuration, or use the LOGTO_APPLICATION_SECRET environment variable.",
 		)
 	}
+	if config.MaxRetries.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("max_retries"),
+			"Unknown Logto max retries",
+			"The provider cannot create the logto API client as there is an unknown configuration value for the maximum number of retries. "+
+				"Either target apply the source of the value first, set the value statically in the configuration, or use the LOGTO_MAX_RETRIES environment variable.",
+		)
+	}
+	if config.RequestsPerSecond.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("requests_per_second"),
+			"Unknown Logto requests per second",
+			"The provider cannot create the logto API client as there is an unknown configuration value for the maximum number of requests per second. "+
+				"Either target apply the source of the value first, set the value statically in the configuration, or use the LOGTO_REQUESTS_PER_SECOND environment variable.",
+		)
+	}
+	if config.MaxConcurrentRequests.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("max_concurrent_requests"),
+			"Unknown Logto max concurrent requests",
+			"The provider cannot create the logto API client as there is an unknown configuration value for the maximum number of concurrent requests. "+
+				"Either target apply the source of the value first, set the value statically in the configuration, or use the LOGTO_MAX_CONCURRENT_REQUESTS environment variable.",
+		)
+	}
+	if config.RetryMaxWait.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("retry_max_wait"),
+			"Unknown Logto retry max wait",
+			"The provider cannot create the logto API client as there is an unknown configuration value for the maximum wait between retries. "+
+				"Either target apply the source of the value first, set the value statically in the configuration, or use the LOGTO_RETRY_MAX_WAIT environment variable.",
+		)
+	}
+	if config.AccessToken.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("access_token"),
+			"Unknown Logto access token",
+			"The provider cannot create the logto API client as there is an unknown configuration value for the access token. "+
+				"Either target apply the source of the value first, set the value statically in the configuration, or use the LOGTO_ACCESS_TOKEN environment variable.",
+		)
+	}
+	if config.PrivateKey.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("private_key"),
+			"Unknown Logto private key",
+			"The provider cannot create the logto API client as there is an unknown configuration value for the private key. "+
+				"Either target apply the source of the value first, set the value statically in the configuration, or use the LOGTO_PRIVATE_KEY environment variable.",
+		)
+	}
+	if config.PrivateKeyFile.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("private_key_file"),
+			"Unknown Logto private key file",
+			"The provider cannot create the logto API client as there is an unknown configuration value for the private key file. "+
+				"Either target apply the source of the value first, set the value statically in the configuration, or use the LOGTO_PRIVATE_KEY_FILE environment variable.",
+		)
+	}
+	if config.PrivateKeyId.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("private_key_id"),
+			"Unknown Logto private key ID",
+			"The provider cannot create the logto API client as there is an unknown configuration value for the private key ID. "+
+				"Either target apply the source of the value first, set the value statically in the configuration, or use the LOGTO_PRIVATE_KEY_ID environment variable.",
+		)
+	}
 
 	if resp.Diagnostics.HasError() {
 		return
@@ -107,6 +196,10 @@ func (p *logtoProvider) Configure(ctx context.Context, req provider.ConfigureReq
 	resource := os.Getenv("LOGTO_RESOURCE")
 	applicationID := os.Getenv("LOGTO_APPLICATION_ID")
 	applicationSecret := os.Getenv("LOGTO_APPLICATION_SECRET")
+	accessToken := os.Getenv("LOGTO_ACCESS_TOKEN")
+	privateKey := os.Getenv("LOGTO_PRIVATE_KEY")
+	privateKeyFile := os.Getenv("LOGTO_PRIVATE_KEY_FILE")
+	privateKeyID := os.Getenv("LOGTO_PRIVATE_KEY_ID")
 
 	if !config.Hostname.IsNull() {
 		hostname = config.Hostname.ValueString()
@@ -120,6 +213,46 @@ func (p *logtoProvider) Configure(ctx context.Context, req provider.ConfigureReq
 	if !config.ApplicationSecret.IsNull() {
 		applicationSecret = config.ApplicationSecret.ValueString()
 	}
+	if !config.AccessToken.IsNull() {
+		accessToken = config.AccessToken.ValueString()
+	}
+	if !config.PrivateKey.IsNull() {
+		privateKey = config.PrivateKey.ValueString()
+	}
+	if !config.PrivateKeyFile.IsNull() {
+		privateKeyFile = config.PrivateKeyFile.ValueString()
+	}
+	if !config.PrivateKeyId.IsNull() {
+		privateKeyID = config.PrivateKeyId.ValueString()
+	}
+
+	retry := client.DefaultRetryPolicy()
+	if maxRetries, ok := int64FromEnv(resp, "max_retries", "LOGTO_MAX_RETRIES", 0); ok {
+		retry.MaxAttempts = int(maxRetries) + 1
+	}
+	if !config.MaxRetries.IsNull() {
+		retry.MaxAttempts = int(config.MaxRetries.ValueInt64()) + 1
+	}
+	if maxWait, ok := int64FromEnv(resp, "retry_max_wait", "LOGTO_RETRY_MAX_WAIT", 1); ok {
+		retry.MaxDelay = time.Duration(maxWait) * time.Second
+	}
+	if !config.RetryMaxWait.IsNull() {
+		retry.MaxDelay = time.Duration(config.RetryMaxWait.ValueInt64()) * time.Second
+	}
+
+	rateLimit := &client.RateLimit{}
+	if requestsPerSecond, ok := float64FromEnv(resp, "requests_per_second", "LOGTO_REQUESTS_PER_SECOND"); ok {
+		rateLimit.RequestsPerSecond = requestsPerSecond
+	}
+	if !config.RequestsPerSecond.IsNull() {
+		rateLimit.RequestsPerSecond = config.RequestsPerSecond.ValueFloat64()
+	}
+	if maxInFlight, ok := int64FromEnv(resp, "max_concurrent_requests", "LOGTO_MAX_CONCURRENT_REQUESTS", 0); ok {
+		rateLimit.MaxInFlight = int(maxInFlight)
+	}
+	if !config.MaxConcurrentRequests.IsNull() {
+		rateLimit.MaxInFlight = int(config.MaxConcurrentRequests.ValueInt64())
+	}
 
 	// If any of the expected configurations are missing, return
 	// errors with provider-specific guidance.
@@ -132,23 +265,67 @@ func (p *logtoProvider) Configure(ctx context.Context, req provider.ConfigureReq
 				"If either is already set, ensure the value is not empty.",
 		)
 	}
-	if applicationID == "" {
-		resp.Diagnostics.AddAttributeError(
-			path.Root("application_id"),
-			"Missing Logto application ID",
-			"The provider cannot create the Logto API client as there is a missing or empty value for the Logto application ID. "+
-				"Set the access_token value in the configuration or use the LOGTO_APPLICATION_ID environment variable. "+
-				"If either is already set, ensure the value is not empty.",
-		)
-	}
-	if applicationSecret == "" {
-		resp.Diagnostics.AddAttributeError(
-			path.Root("application_secret"),
-			"Missing Logto application secret",
-			"The provider cannot create the Logto API client as there is a missing or empty value for the Logto application secret. "+
-				"Set the access_token value in the configuration or use the LOGTO_APPLICATION_SECRET environment variable. "+
-				"If either is already set, ensure the value is not empty.",
-		)
+
+	// The access token takes precedence, then the private key, and the
+	// application secret is used otherwise.
+	var authenticator client.Authenticator
+	switch {
+	case accessToken != "":
+		authenticator = &client.StaticToken{Token: accessToken}
+
+	case privateKey != "" || privateKeyFile != "":
+		if privateKey != "" && privateKeyFile != "" {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("private_key"),
+				"Conflicting Logto private keys",
+				"The provider cannot create the Logto API client as both a private key and a private key file are set. "+
+					"Only set one of the private_key and private_key_file values, or of the LOGTO_PRIVATE_KEY and LOGTO_PRIVATE_KEY_FILE environment variables.",
+			)
+			return
+		}
+
+		keyPath := path.Root("private_key")
+		pemData := []byte(privateKey)
+		if privateKeyFile != "" {
+			keyPath = path.Root("private_key_file")
+
+			var err error
+			pemData, err = os.ReadFile(privateKeyFile)
+			if err != nil {
+				resp.Diagnostics.AddAttributeError(keyPath, "Failed to read Logto private key file", err.Error())
+				return
+			}
+		}
+
+		key, err := client.ParsePrivateKey(pemData)
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(keyPath, "Invalid Logto private key", err.Error())
+			return
+		}
+
+		missingApplicationID(resp, applicationID)
+		authenticator = &client.PrivateKeyJWT{
+			ApplicationID: applicationID,
+			Key:           key,
+			KeyID:         privateKeyID,
+		}
+
+	default:
+		missingApplicationID(resp, applicationID)
+		if applicationSecret == "" {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("application_secret"),
+				"Missing Logto application secret",
+				"The provider cannot create the Logto API client as there is a missing or empty value for the Logto application secret. "+
+					"Set the application_secret value in the configuration or use the LOGTO_APPLICATION_SECRET environment variable. "+
+					"Alternatively, authenticate with a private key or an access token. "+
+					"If either is already set, ensure the value is not empty.",
+			)
+		}
+		authenticator = &client.ClientSecret{
+			ApplicationID:     applicationID,
+			ApplicationSecret: applicationSecret,
+		}
 	}
 
 	if resp.Diagnostics.HasError() {
@@ -158,6 +335,7 @@ func (p *logtoProvider) Configure(ctx context.Context, req provider.ConfigureReq
 	ctx = tflog.SetField(ctx, "logto_hostname", hostname)
 	ctx = tflog.SetField(ctx, "logto_application_id", applicationID)
 	ctx = tflog.SetField(ctx, "logto_application_secret", applicationSecret)
+	ctx = tflog.MaskFieldValuesWithFieldKeys(ctx, "logto_application_secret")
 
 	tflog.Debug(ctx, "Creating Logto client")
 
@@ -166,6 +344,10 @@ func (p *logtoProvider) Configure(ctx context.Context, req provider.ConfigureReq
 		Resource:          resource,
 		ApplicationID:     applicationID,
 		ApplicationSecret: applicationSecret,
+		HttpClient:        p.httpClient,
+		Retry:             retry,
+		RateLimit:         rateLimit,
+		Authenticator:     authenticator,
 	}
 
 	if os.Getenv("TF_PROVIDER_LOGTO_LOG") != "" {
@@ -178,26 +360,106 @@ func (p *logtoProvider) Configure(ctx context.Context, req provider.ConfigureReq
 		return
 	}
 
-	// Make the Logto client available during DataSource and Resource
-	// type Configure methods.
+	// Make the Logto client available during DataSource, Resource and
+	// ListResource type Configure methods.
 	resp.DataSourceData = apiClient
 	resp.ResourceData = apiClient
+	resp.ListResourceData = apiClient
 
 	tflog.Info(ctx, "Configured Logto client", map[string]any{"success": true})
 }
 
 // DataSources defines the data sources implemented in the provider.
 func (p *logtoProvider) DataSources(_ context.Context) []func() datasource.DataSource {
-	return []func() datasource.DataSource{}
+	return []func() datasource.DataSource{
+		datasource_application.ApplicationDataSource,
+		datasource_application_secrets.ApplicationSecretsDataSource,
+		datasource_user.UserDataSource,
+		datasource_api_resource.ApiResourceDataSource,
+		datasource_api_resource_scope.ApiResourceScopeDataSource,
+		datasource_role.RoleDataSource,
+	}
 }
 
 // Resources defines the resources implemented in the provider.
 func (p *logtoProvider) Resources(_ context.Context) []func() resource.Resource {
 	return []func() resource.Resource{
 		resource_application.ApplicationResource,
+		resource_application_secret.ApplicationSecretResource,
 		resource_user.UserResource,
+		resource_user_identity.UserIdentityResource,
+		resource_user_roles.UserRolesResource,
 		resource_api_resource.ApiResourceResource,
 		resource_api_resource_scope.ApiResourceScopeResource,
 		resource_role.RoleResource,
+		resource_role_assignment.RoleAssignmentResource,
+		resource_role_scopes.RoleScopesResource,
+		resource_organization.OrganizationResource,
+		resource_organization_membership.OrganizationMembershipResource,
+		resource_organization_role.OrganizationRoleResource,
+		resource_organization_scope.OrganizationScopeResource,
+	}
+}
+
+// ListResources defines the list resources implemented in the provider.
+func (p *logtoProvider) ListResources(_ context.Context) []func() list.ListResource {
+	return []func() list.ListResource{
+		resource_application.ApplicationListResource,
+		resource_user.UserListResource,
+		resource_api_resource.ApiResourceListResource,
+		resource_role.RoleListResource,
+	}
+}
+
+func missingApplicationID(resp *provider.ConfigureResponse, applicationID string) {
+	if applicationID != "" {
+		return
+	}
+	resp.Diagnostics.AddAttributeError(
+		path.Root("application_id"),
+		"Missing Logto application ID",
+		"The provider cannot create the Logto API client as there is a missing or empty value for the Logto application ID. "+
+			"Set the application_id value in the configuration or use the LOGTO_APPLICATION_ID environment variable. "+
+			"If either is already set, ensure the value is not empty.",
+	)
+}
+
+// int64FromEnv parses the integer set in the given environment variable,
+// reporting an error on attribute when it is not a number or lower than min.
+func int64FromEnv(resp *provider.ConfigureResponse, attribute, env string, atLeast int64) (int64, bool) {
+	raw := os.Getenv(env)
+	if raw == "" {
+		return 0, false
+	}
+
+	value, err := strconv.ParseInt(raw, 10, 64)
+	if err != nil || value < atLeast {
+		resp.Diagnostics.AddAttributeError(
+			path.Root(attribute),
+			"Invalid "+env+" environment variable",
+			"The "+env+" environment variable must be an integer greater than or equal to "+strconv.FormatInt(atLeast, 10)+", got "+strconv.Quote(raw)+".",
+		)
+		return 0, false
+	}
+	return value, true
+}
+
+// float64FromEnv parses the positive number set in the given environment
+// variable, reporting an error on attribute when it is invalid.
+func float64FromEnv(resp *provider.ConfigureResponse, attribute, env string) (float64, bool) {
+	raw := os.Getenv(env)
+	if raw == "" {
+		return 0, false
+	}
+
+	value, err := strconv.ParseFloat(raw, 64)
+	if err != nil || value < 0 || math.IsInf(value, 0) || math.IsNaN(value) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root(attribute),
+			"Invalid "+env+" environment variable",
+			"The "+env+" environment variable must be a positive number, got "+strconv.Quote(raw)+".",
+		)
+		return 0, false
 	}
+	return value, true
 }
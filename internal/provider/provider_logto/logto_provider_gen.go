@@ -4,6 +4,11 @@ package provider_logto
 
 import (
 	"context"
+	"github.com/hashicorp/terraform-plugin-framework-validators/float64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
@@ -12,6 +17,15 @@ import (
 func LogtoProviderSchema(ctx context.Context) schema.Schema {
 	return schema.Schema{
 		Attributes: map[string]schema.Attribute{
+			"access_token": schema.StringAttribute{
+				Optional:            true,
+				Sensitive:           true,
+				Description:         "An access token to the Management API obtained beforehand, for example by a CI pipeline, can be set as environment variable LOGTO_ACCESS_TOKEN. It is used as is and takes precedence over the other authentication methods.",
+				MarkdownDescription: "An access token to the Management API obtained beforehand, for example by a CI pipeline, can be set as environment variable LOGTO_ACCESS_TOKEN. It is used as is and takes precedence over the other authentication methods.",
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("private_key"), path.MatchRoot("private_key_file")),
+				},
+			},
 			"application_id": schema.StringAttribute{
 				Optional:            true,
 				Description:         "The application id for your instance, can be set as environment variable LOGTO_APPLICATION_ID.",
@@ -27,18 +41,77 @@ func LogtoProviderSchema(ctx context.Context) schema.Schema {
 				Description:         "The API hostname for your instance, can be set as environment variable LOGTO_HOSTNAME.",
 				MarkdownDescription: "The API hostname for your instance, can be set as environment variable LOGTO_HOSTNAME.",
 			},
+			"max_concurrent_requests": schema.Int64Attribute{
+				Optional:            true,
+				Description:         "The maximum number of requests sent concurrently to Logto across all resources, can be set as environment variable LOGTO_MAX_CONCURRENT_REQUESTS. Defaults to 0, meaning no limit.",
+				MarkdownDescription: "The maximum number of requests sent concurrently to Logto across all resources, can be set as environment variable LOGTO_MAX_CONCURRENT_REQUESTS. Defaults to 0, meaning no limit.",
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"max_retries": schema.Int64Attribute{
+				Optional:            true,
+				Description:         "The number of times a request failing with a transient error is retried, can be set as environment variable LOGTO_MAX_RETRIES. Defaults to 3, set to 0 to disable retries.",
+				MarkdownDescription: "The number of times a request failing with a transient error is retried, can be set as environment variable LOGTO_MAX_RETRIES. Defaults to 3, set to 0 to disable retries.",
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"private_key": schema.StringAttribute{
+				Optional:            true,
+				Sensitive:           true,
+				Description:         "The PEM encoded private key used to authenticate the application with a signed client assertion (private_key_jwt) instead of its secret, can be set as environment variable LOGTO_PRIVATE_KEY. RSA, ECDSA and Ed25519 keys are supported.",
+				MarkdownDescription: "The PEM encoded private key used to authenticate the application with a signed client assertion (private_key_jwt) instead of its secret, can be set as environment variable LOGTO_PRIVATE_KEY. RSA, ECDSA and Ed25519 keys are supported.",
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("private_key_file")),
+				},
+			},
+			"private_key_file": schema.StringAttribute{
+				Optional:            true,
+				Description:         "The path to a file holding the PEM encoded private key used to authenticate the application with a signed client assertion (private_key_jwt), can be set as environment variable LOGTO_PRIVATE_KEY_FILE.",
+				MarkdownDescription: "The path to a file holding the PEM encoded private key used to authenticate the application with a signed client assertion (private_key_jwt), can be set as environment variable LOGTO_PRIVATE_KEY_FILE.",
+			},
+			"private_key_id": schema.StringAttribute{
+				Optional:            true,
+				Description:         "The identifier of the private key, sent as the kid header of the client assertion, can be set as environment variable LOGTO_PRIVATE_KEY_ID.",
+				MarkdownDescription: "The identifier of the private key, sent as the kid header of the client assertion, can be set as environment variable LOGTO_PRIVATE_KEY_ID.",
+			},
+			"requests_per_second": schema.Float64Attribute{
+				Optional:            true,
+				Description:         "The maximum number of requests per second sent to Logto across all resources, can be set as environment variable LOGTO_REQUESTS_PER_SECOND. Defaults to 0, meaning no limit.",
+				MarkdownDescription: "The maximum number of requests per second sent to Logto across all resources, can be set as environment variable LOGTO_REQUESTS_PER_SECOND. Defaults to 0, meaning no limit.",
+				Validators: []validator.Float64{
+					float64validator.AtLeast(0),
+				},
+			},
 			"resource": schema.StringAttribute{
 				Optional:            true,
 				Description:         "The application resource for your instance, can be set as environment variable LOGTO_RESOURCE. This is only needed when connecting to an on-premise Logto instance.",
 				MarkdownDescription: "The application resource for your instance, can be set as environment variable LOGTO_RESOURCE. This is only needed when connecting to an on-premise Logto instance.",
 			},
+			"retry_max_wait": schema.Int64Attribute{
+				Optional:            true,
+				Description:         "The maximum number of seconds to wait between two attempts, including when Logto asks to wait longer through the Retry-After header, can be set as environment variable LOGTO_RETRY_MAX_WAIT. Defaults to 30.",
+				MarkdownDescription: "The maximum number of seconds to wait between two attempts, including when Logto asks to wait longer through the Retry-After header, can be set as environment variable LOGTO_RETRY_MAX_WAIT. Defaults to 30.",
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
 		},
 	}
 }
 
 type LogtoModel struct {
-	ApplicationId     types.String `tfsdk:"application_id"`
-	ApplicationSecret types.String `tfsdk:"application_secret"`
-	Hostname          types.String `tfsdk:"hostname"`
-	Resource          types.String `tfsdk:"resource"`
+	AccessToken           types.String  `tfsdk:"access_token"`
+	ApplicationId         types.String  `tfsdk:"application_id"`
+	ApplicationSecret     types.String  `tfsdk:"application_secret"`
+	Hostname              types.String  `tfsdk:"hostname"`
+	MaxConcurrentRequests types.Int64   `tfsdk:"max_concurrent_requests"`
+	MaxRetries            types.Int64   `tfsdk:"max_retries"`
+	PrivateKey            types.String  `tfsdk:"private_key"`
+	PrivateKeyFile        types.String  `tfsdk:"private_key_file"`
+	PrivateKeyId          types.String  `tfsdk:"private_key_id"`
+	RequestsPerSecond     types.Float64 `tfsdk:"requests_per_second"`
+	Resource              types.String  `tfsdk:"resource"`
+	RetryMaxWait          types.Int64   `tfsdk:"retry_max_wait"`
 }
@@ -1,6 +1,10 @@
 package provider_logto
 
 import (
+	"os"
+	"testing"
+
+	"github.com/Lenstra/terraform-provider-logto/client/logtotest"
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
 )
@@ -24,4 +28,39 @@ var (
 	TestAccProtoV6ProviderFactories = map[string]func() (tfprotov6.ProviderServer, error){
 		"logto": providerserver.NewProtocol6WithError(New("test")()),
 	}
+
+	// fakeServer is the in-process Logto server the acceptance tests run
+	// against when no tenant is configured, it is nil otherwise.
+	fakeServer *logtotest.Server
 )
+
+func TestMain(m *testing.M) {
+	if os.Getenv("TF_ACC") != "" && os.Getenv("LOGTO_HOSTNAME") == "" {
+		fakeServer = logtotest.NewServer()
+
+		os.Setenv("LOGTO_HOSTNAME", fakeServer.Hostname())
+		os.Setenv("LOGTO_APPLICATION_ID", fakeServer.ApplicationID)
+		os.Setenv("LOGTO_APPLICATION_SECRET", fakeServer.ApplicationSecret)
+
+		TestAccProtoV6ProviderFactories["logto"] = providerserver.NewProtocol6WithError(&logtoProvider{
+			version:    "test",
+			httpClient: fakeServer.Client(),
+		})
+	}
+
+	code := m.Run()
+
+	if fakeServer != nil {
+		fakeServer.Close()
+	}
+	os.Exit(code)
+}
+
+// skipWithFakeServer skips the tests relying on objects that only exist in
+// the Logto tenant used by the CI.
+func skipWithFakeServer(t *testing.T) {
+	t.Helper()
+	if fakeServer != nil {
+		t.Skip("requires objects pre-provisioned in a real Logto tenant")
+	}
+}
@@ -0,0 +1,89 @@
+package provider_logto
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+const organizationMembershipConfig = `
+	resource "logto_organization" "test_organization" {
+		name = "tf_test_organization"
+	}
+
+	resource "logto_organization_role" "member" {
+		name = "tf_test_member"
+	}
+
+	resource "logto_organization_role" "admin" {
+		name = "tf_test_admin"
+	}
+
+	resource "logto_user" "test_user" {
+		username      = "tf_test_membership"
+		primary_email = "tf_test_membership@test.fr"
+		name          = "tf_test_membership"
+	}
+`
+
+func TestAccOrganizationMembershipResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: ProviderConfig + organizationMembershipConfig + `
+					resource "logto_organization_membership" "test_membership" {
+						organization_id = logto_organization.test_organization.id
+						user_id         = logto_user.test_user.id
+					}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					// Verify attributes
+					resource.TestCheckResourceAttrPair("logto_organization_membership.test_membership", "organization_id", "logto_organization.test_organization", "id"),
+					resource.TestCheckResourceAttrPair("logto_organization_membership.test_membership", "user_id", "logto_user.test_user", "id"),
+					resource.TestCheckResourceAttr("logto_organization_membership.test_membership", "organization_role_ids.#", "0"),
+
+					// Verify dynamic values have any value set in the state.
+					resource.TestCheckResourceAttrSet("logto_organization_membership.test_membership", "id"),
+				),
+			},
+			// Update and Read testing
+			{
+				Config: ProviderConfig + organizationMembershipConfig + `
+					resource "logto_organization_membership" "test_membership" {
+						organization_id = logto_organization.test_organization.id
+						user_id         = logto_user.test_user.id
+
+						organization_role_ids = [
+							logto_organization_role.member.id,
+							logto_organization_role.admin.id,
+						]
+					}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					// Verify attributes
+					resource.TestCheckResourceAttr("logto_organization_membership.test_membership", "organization_role_ids.#", "2"),
+					resource.TestCheckTypeSetElemAttrPair("logto_organization_membership.test_membership", "organization_role_ids.*", "logto_organization_role.member", "id"),
+					resource.TestCheckTypeSetElemAttrPair("logto_organization_membership.test_membership", "organization_role_ids.*", "logto_organization_role.admin", "id"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "logto_organization_membership.test_membership",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					rs, ok := s.RootModule().Resources["logto_organization_membership.test_membership"]
+					if !ok {
+						return "", fmt.Errorf("resource not found in state")
+					}
+					return fmt.Sprintf("%s/%s", rs.Primary.Attributes["organization_id"], rs.Primary.Attributes["user_id"]), nil
+				},
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
@@ -0,0 +1,71 @@
+package provider_logto
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccOrganizationResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: ProviderConfig + `
+					resource "logto_organization" "test_organization" {
+						name        = "tf_test_organization"
+						description = "tf_test_organization_description"
+
+						custom_data = jsonencode({
+							plan = "free"
+						})
+
+						branding = {
+							logo_url = "https://logo.test/logo.png"
+						}
+					}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					// Verify attributes
+					resource.TestCheckResourceAttr("logto_organization.test_organization", "name", "tf_test_organization"),
+					resource.TestCheckResourceAttr("logto_organization.test_organization", "description", "tf_test_organization_description"),
+					resource.TestCheckResourceAttr("logto_organization.test_organization", "custom_data", `{"plan":"free"}`),
+					resource.TestCheckResourceAttr("logto_organization.test_organization", "is_mfa_required", "false"),
+					resource.TestCheckResourceAttr("logto_organization.test_organization", "branding.logo_url", "https://logo.test/logo.png"),
+					resource.TestCheckResourceAttr("logto_organization.test_organization", "branding.dark_logo_url", ""),
+
+					// Verify dynamic values have any value set in the state.
+					resource.TestCheckResourceAttrSet("logto_organization.test_organization", "id"),
+					resource.TestCheckResourceAttrSet("logto_organization.test_organization", "tenant_id"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "logto_organization.test_organization",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: ProviderConfig + `
+					resource "logto_organization" "test_organization" {
+						name            = "tf_test_organization_modified"
+						description     = "tf_test_organization_description_modified"
+						is_mfa_required = true
+
+						custom_data = jsonencode({})
+					}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					// Verify attributes
+					resource.TestCheckResourceAttr("logto_organization.test_organization", "name", "tf_test_organization_modified"),
+					resource.TestCheckResourceAttr("logto_organization.test_organization", "description", "tf_test_organization_description_modified"),
+					resource.TestCheckResourceAttr("logto_organization.test_organization", "custom_data", `{}`),
+					resource.TestCheckResourceAttr("logto_organization.test_organization", "is_mfa_required", "true"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
@@ -0,0 +1,103 @@
+package provider_logto
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccOrganizationRoleResource(t *testing.T) {
+	var id string
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: ProviderConfig + `
+					resource "logto_organization_scope" "read" {
+						name = "read:tf_test"
+					}
+
+					resource "logto_organization_role" "test_role" {
+						name        = "tf_test_organization_role"
+						description = "tf_test_organization_role_description"
+
+						organization_scope_ids = [
+							logto_organization_scope.read.id,
+						]
+					}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					// Verify attributes
+					resource.TestCheckResourceAttr("logto_organization_role.test_role", "name", "tf_test_organization_role"),
+					resource.TestCheckResourceAttr("logto_organization_role.test_role", "description", "tf_test_organization_role_description"),
+					resource.TestCheckResourceAttr("logto_organization_role.test_role", "type", "User"),
+					resource.TestCheckResourceAttr("logto_organization_role.test_role", "organization_scope_ids.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair("logto_organization_role.test_role", "organization_scope_ids.*", "logto_organization_scope.read", "id"),
+					resource.TestCheckNoResourceAttr("logto_organization_role.test_role", "resource_scope_ids"),
+
+					// Verify dynamic values have any value set in the state.
+					resource.TestCheckResourceAttrWith("logto_organization_role.test_role", "id", func(value string) error {
+						id = value
+						return nil
+					}),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "logto_organization_role.test_role",
+				ImportState:       true,
+				ImportStateVerify: true,
+				// The scopes are only tracked when they are set in the
+				// configuration.
+				ImportStateVerifyIgnore: []string{"organization_scope_ids"},
+			},
+			// Update and Read testing
+			{
+				Config: ProviderConfig + `
+					resource "logto_organization_scope" "read" {
+						name = "read:tf_test"
+					}
+
+					resource "logto_api_resource" "api_resource" {
+						name      = "tf_api_resource"
+						indicator = "https://api-resource.test"
+					}
+
+					resource "logto_api_resource_scope" "api_resource_scope" {
+						name        = "tf_scope"
+						resource_id = logto_api_resource.api_resource.id
+					}
+
+					resource "logto_organization_role" "test_role" {
+						name        = "tf_test_organization_role_modified"
+						description = "tf_test_organization_role_description_modified"
+
+						organization_scope_ids = []
+						resource_scope_ids = [
+							logto_api_resource_scope.api_resource_scope.id,
+						]
+					}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					// Verify attributes
+					resource.TestCheckResourceAttr("logto_organization_role.test_role", "name", "tf_test_organization_role_modified"),
+					resource.TestCheckResourceAttr("logto_organization_role.test_role", "description", "tf_test_organization_role_description_modified"),
+					resource.TestCheckResourceAttr("logto_organization_role.test_role", "organization_scope_ids.#", "0"),
+					resource.TestCheckResourceAttr("logto_organization_role.test_role", "resource_scope_ids.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair("logto_organization_role.test_role", "resource_scope_ids.*", "logto_api_resource_scope.api_resource_scope", "id"),
+
+					// The role is updated in place.
+					resource.TestCheckResourceAttrWith("logto_organization_role.test_role", "id", func(value string) error {
+						if value != id {
+							return fmt.Errorf("the organization role was recreated: %s != %s", value, id)
+						}
+						return nil
+					}),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
@@ -0,0 +1,53 @@
+package provider_logto
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccOrganizationScopeResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: ProviderConfig + `
+					resource "logto_organization_scope" "test_scope" {
+						name        = "read:tf_test"
+						description = "tf_test_organization_scope_description"
+					}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					// Verify attributes
+					resource.TestCheckResourceAttr("logto_organization_scope.test_scope", "name", "read:tf_test"),
+					resource.TestCheckResourceAttr("logto_organization_scope.test_scope", "description", "tf_test_organization_scope_description"),
+
+					// Verify dynamic values have any value set in the state.
+					resource.TestCheckResourceAttrSet("logto_organization_scope.test_scope", "id"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "logto_organization_scope.test_scope",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: ProviderConfig + `
+					resource "logto_organization_scope" "test_scope" {
+						name        = "write:tf_test"
+						description = "tf_test_organization_scope_description_modified"
+					}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					// Verify attributes
+					resource.TestCheckResourceAttr("logto_organization_scope.test_scope", "name", "write:tf_test"),
+					resource.TestCheckResourceAttr("logto_organization_scope.test_scope", "description", "tf_test_organization_scope_description_modified"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
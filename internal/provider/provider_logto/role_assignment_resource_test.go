@@ -0,0 +1,97 @@
+package provider_logto
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccRoleAssignmentResource(t *testing.T) {
+	config := ProviderConfig + `
+		resource "logto_role" "user_role" {
+			name        = "tf_test_role_assignment_user"
+			description = "User role"
+		}
+
+		resource "logto_role" "app_role" {
+			name        = "tf_test_role_assignment_app"
+			description = "Machine-to-machine role"
+			type        = "MachineToMachine"
+		}
+
+		resource "logto_user" "alice" {
+			username = "tf_test_role_assignment_alice"
+		}
+
+		resource "logto_user" "bob" {
+			username = "tf_test_role_assignment_bob"
+		}
+
+		resource "logto_application" "m2m" {
+			name = "tf_test_role_assignment"
+			type = "MachineToMachine"
+		}
+	`
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: config + `
+					resource "logto_role_assignment" "users" {
+						role_id  = logto_role.user_role.id
+						user_ids = [logto_user.alice.id, logto_user.bob.id]
+					}
+
+					resource "logto_role_assignment" "applications" {
+						role_id         = logto_role.app_role.id
+						application_ids = [logto_application.m2m.id]
+					}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("logto_role_assignment.users", "id", "logto_role.user_role", "id"),
+					resource.TestCheckResourceAttr("logto_role_assignment.users", "user_ids.#", "2"),
+					resource.TestCheckTypeSetElemAttrPair("logto_role_assignment.users", "user_ids.*", "logto_user.alice", "id"),
+					resource.TestCheckTypeSetElemAttrPair("logto_role_assignment.users", "user_ids.*", "logto_user.bob", "id"),
+					resource.TestCheckNoResourceAttr("logto_role_assignment.users", "application_ids"),
+
+					resource.TestCheckResourceAttr("logto_role_assignment.applications", "application_ids.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair("logto_role_assignment.applications", "application_ids.*", "logto_application.m2m", "id"),
+					resource.TestCheckNoResourceAttr("logto_role_assignment.applications", "user_ids"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "logto_role_assignment.users",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				ResourceName:      "logto_role_assignment.applications",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: config + `
+					resource "logto_role_assignment" "users" {
+						role_id  = logto_role.user_role.id
+						user_ids = [logto_user.bob.id]
+					}
+
+					resource "logto_role_assignment" "applications" {
+						role_id         = logto_role.app_role.id
+						application_ids = []
+					}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("logto_role_assignment.users", "user_ids.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair("logto_role_assignment.users", "user_ids.*", "logto_user.bob", "id"),
+					resource.TestCheckResourceAttr("logto_role_assignment.applications", "application_ids.#", "0"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
@@ -0,0 +1,37 @@
+package provider_logto
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccRoleDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: ProviderConfig + `
+					resource "logto_role" "test_role" {
+						name        = "tf_test_role_data_source"
+						description = "test role"
+					}
+
+					data "logto_role" "by_id" {
+						id = logto_role.test_role.id
+					}
+
+					data "logto_role" "by_name" {
+						name = logto_role.test_role.name
+					}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.logto_role.by_id", "name", "logto_role.test_role", "name"),
+					resource.TestCheckResourceAttrPair("data.logto_role.by_name", "id", "logto_role.test_role", "id"),
+					resource.TestCheckResourceAttr("data.logto_role.by_name", "description", "test role"),
+					resource.TestCheckResourceAttr("data.logto_role.by_name", "type", "User"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,68 @@
+package provider_logto
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/querycheck"
+	"github.com/hashicorp/terraform-plugin-testing/querycheck/queryfilter"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAccRoleListResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		ProtoV6ProviderFactories: TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: ProviderConfig + `
+					resource "logto_role" "user" {
+						name        = "tf_list_role_user"
+						description = "tf_list_role_user"
+					}
+
+					resource "logto_role" "machine_to_machine" {
+						name        = "tf_list_role_m2m"
+						description = "tf_list_role_m2m"
+						type        = "MachineToMachine"
+					}
+				`,
+			},
+			{
+				Query: true,
+				Config: ProviderConfig + `
+					list "logto_role" "test" {
+						provider         = logto
+						include_resource = true
+
+						config {
+							search = "tf_list_role_%"
+							type   = "MachineToMachine"
+						}
+					}
+				`,
+				QueryResultChecks: []querycheck.QueryResultCheck{
+					querycheck.ExpectLength("logto_role.test", 1),
+					querycheck.ExpectResourceKnownValues(
+						"logto_role.test",
+						queryfilter.ByDisplayName(knownvalue.StringExact("tf_list_role_m2m")),
+						[]querycheck.KnownValueCheck{
+							{
+								Path:       tfjsonpath.New("type"),
+								KnownValue: knownvalue.StringExact("MachineToMachine"),
+							},
+							{
+								Path:       tfjsonpath.New("scope_ids"),
+								KnownValue: knownvalue.ListSizeExact(0),
+							},
+						},
+					),
+				},
+			},
+		},
+	})
+}
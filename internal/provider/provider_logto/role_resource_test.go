@@ -35,6 +35,13 @@ func TestAccRoleRessource(t *testing.T) {
 				ImportState:       true,
 				ImportStateVerify: true,
 			},
+			// ImportState testing by name
+			{
+				ResourceName:      "logto_role.test_role",
+				ImportState:       true,
+				ImportStateId:     "tf_test_role",
+				ImportStateVerify: true,
+			},
 			// Update and Read testing
 			{
 				Config: ProviderConfig + `
@@ -164,6 +171,79 @@ func TestAccRoleRessourceRecreation(t *testing.T) {
 	})
 }
 
+func TestAccRoleRessourceImportWithScopes(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: ProviderConfig + `
+							resource "logto_api_resource" "api_resource" {
+									name 				     = "tf_api_resource"
+									indicator        = "https://api-resource.test"
+									access_token_ttl = 3600
+							}
+
+							resource "logto_api_resource_scope" "api_resource_scope" {
+									name 				= "tf_scope"
+									resource_id = logto_api_resource.api_resource.id
+									description = "test_scope_description"
+							}
+
+							resource "logto_role" "test_role" {
+									name 				= "tf_test_role"
+									description = "tf_test_role_description"
+									type				= "User"
+									scope_ids   = [
+										logto_api_resource_scope.api_resource_scope.id
+									]
+							}
+							`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("logto_role.test_role", "scope_ids.#", "1"),
+				),
+			},
+			// ImportState testing by name, the scopes of the role are imported
+			{
+				ResourceName:      "logto_role.test_role",
+				ImportState:       true,
+				ImportStateId:     "tf_test_role",
+				ImportStateVerify: true,
+			},
+			// Removing scope_ids stops managing the scopes without removing them
+			{
+				Config: ProviderConfig + `
+							resource "logto_api_resource" "api_resource" {
+									name 				     = "tf_api_resource"
+									indicator        = "https://api-resource.test"
+									access_token_ttl = 3600
+							}
+
+							resource "logto_api_resource_scope" "api_resource_scope" {
+									name 				= "tf_scope"
+									resource_id = logto_api_resource.api_resource.id
+									description = "test_scope_description"
+							}
+
+							resource "logto_role" "test_role" {
+									name 				= "tf_test_role"
+									description = "tf_test_role_description"
+									type				= "User"
+							}
+
+							data "logto_role" "test_role" {
+									id = logto_role.test_role.id
+							}
+							`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckNoResourceAttr("logto_role.test_role", "scope_ids.#"),
+					resource.TestCheckResourceAttr("data.logto_role.test_role", "scope_ids.#", "1"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccRoleRessourceAddScopeIdsAfterCreation(t *testing.T) {
 	resource.Test(t, resource.TestCase{
 		ProtoV6ProviderFactories: TestAccProtoV6ProviderFactories,
@@ -0,0 +1,142 @@
+package provider_logto
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccRoleScopesResource(t *testing.T) {
+	config := ProviderConfig + `
+		resource "logto_api_resource" "api_resource" {
+			name      = "tf_test_role_scopes"
+			indicator = "https://role-scopes.test"
+		}
+
+		resource "logto_api_resource_scope" "read" {
+			name        = "read"
+			resource_id = logto_api_resource.api_resource.id
+		}
+
+		resource "logto_api_resource_scope" "write" {
+			name        = "write"
+			resource_id = logto_api_resource.api_resource.id
+		}
+
+		resource "logto_role" "test_role" {
+			name        = "tf_test_role_scopes"
+			description = "tf_test_role_scopes_description"
+		}
+	`
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing, each resource only sees its own scopes
+			{
+				Config: config + `
+					resource "logto_role_scopes" "read" {
+						role_id   = logto_role.test_role.id
+						scope_ids = [logto_api_resource_scope.read.id]
+					}
+
+					resource "logto_role_scopes" "write" {
+						role_id   = logto_role.test_role.id
+						scope_ids = [logto_api_resource_scope.write.id]
+					}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("logto_role_scopes.read", "id", "logto_role.test_role", "id"),
+					resource.TestCheckResourceAttr("logto_role_scopes.read", "scope_ids.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair("logto_role_scopes.read", "scope_ids.*", "logto_api_resource_scope.read", "id"),
+					resource.TestCheckResourceAttr("logto_role_scopes.write", "scope_ids.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair("logto_role_scopes.write", "scope_ids.*", "logto_api_resource_scope.write", "id"),
+					resource.TestCheckNoResourceAttr("logto_role.test_role", "scope_ids.#"),
+				),
+			},
+			// Update and Read testing
+			{
+				Config: config + `
+					resource "logto_role_scopes" "read" {
+						role_id   = logto_role.test_role.id
+						scope_ids = [logto_api_resource_scope.read.id, logto_api_resource_scope.write.id]
+					}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("logto_role_scopes.read", "scope_ids.#", "2"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "logto_role_scopes.read",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccRoleResourceUpdateScopeIds(t *testing.T) {
+	config := ProviderConfig + `
+		resource "logto_api_resource" "api_resource" {
+			name      = "tf_test_role_scope_ids"
+			indicator = "https://role-scope-ids.test"
+		}
+
+		resource "logto_api_resource_scope" "read" {
+			name        = "read"
+			resource_id = logto_api_resource.api_resource.id
+		}
+
+		resource "logto_api_resource_scope" "write" {
+			name        = "write"
+			resource_id = logto_api_resource.api_resource.id
+		}
+	`
+
+	var id string
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config + `
+					resource "logto_role" "test_role" {
+						name        = "tf_test_role_scope_ids"
+						description = "tf_test_role_scope_ids_description"
+						scope_ids   = [logto_api_resource_scope.read.id]
+					}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("logto_role.test_role", "scope_ids.#", "1"),
+					resource.TestCheckResourceAttrWith("logto_role.test_role", "id", func(value string) error {
+						id = value
+						return nil
+					}),
+				),
+			},
+			// The scopes are updated without recreating the role
+			{
+				Config: config + `
+					resource "logto_role" "test_role" {
+						name        = "tf_test_role_scope_ids"
+						description = "tf_test_role_scope_ids_description"
+						scope_ids   = [logto_api_resource_scope.write.id, logto_api_resource_scope.read.id]
+					}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("logto_role.test_role", "scope_ids.#", "2"),
+					resource.TestCheckResourceAttrPair("logto_role.test_role", "scope_ids.0", "logto_api_resource_scope.write", "id"),
+					resource.TestCheckResourceAttrWith("logto_role.test_role", "id", func(value string) error {
+						if value != id {
+							return fmt.Errorf("the role was recreated: %s != %s", value, id)
+						}
+						return nil
+					}),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
@@ -0,0 +1,43 @@
+package provider_logto
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccUserDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: ProviderConfig + `
+					resource "logto_user" "test_user" {
+						username      = "tf_test_user_data_source"
+						primary_email = "tf_test_user_data_source@test.fr"
+						name          = "tf_test_user_data_source"
+					}
+
+					data "logto_user" "by_id" {
+						id = logto_user.test_user.id
+					}
+
+					data "logto_user" "by_username" {
+						username = logto_user.test_user.username
+					}
+
+					data "logto_user" "by_email" {
+						primary_email = logto_user.test_user.primary_email
+					}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.logto_user.by_id", "username", "logto_user.test_user", "username"),
+					resource.TestCheckResourceAttrPair("data.logto_user.by_username", "id", "logto_user.test_user", "id"),
+					resource.TestCheckResourceAttrPair("data.logto_user.by_email", "id", "logto_user.test_user", "id"),
+					resource.TestCheckResourceAttr("data.logto_user.by_email", "name", "tf_test_user_data_source"),
+					resource.TestCheckResourceAttr("data.logto_user.by_email", "role_ids.#", "0"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,107 @@
+package provider_logto
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccUserIdentityResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: ProviderConfig + `
+					resource "logto_user" "test_user" {
+						username = "tf_test_user_identity"
+					}
+
+					resource "logto_user_identity" "github" {
+						user_id     = logto_user.test_user.id
+						target      = "github"
+						identity_id = "1234"
+						details = jsonencode({
+							email = "tf_test_user_identity@test.fr"
+						})
+					}
+
+					data "logto_user" "test_user" {
+						id = logto_user_identity.github.user_id
+					}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("logto_user_identity.github", "id"),
+					resource.TestCheckResourceAttr("logto_user_identity.github", "identity_id", "1234"),
+					resource.TestCheckResourceAttr("logto_user_identity.github", "details", `{"email":"tf_test_user_identity@test.fr"}`),
+
+					resource.TestCheckResourceAttr("data.logto_user.test_user", "identities.%", "1"),
+					resource.TestCheckResourceAttr("data.logto_user.test_user", "identities.github.identity_id", "1234"),
+					resource.TestCheckResourceAttr("data.logto_user.test_user", "sso_identities.#", "0"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "logto_user_identity.github",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: ProviderConfig + `
+					resource "logto_user" "test_user" {
+						username = "tf_test_user_identity"
+					}
+
+					resource "logto_user_identity" "github" {
+						user_id     = logto_user.test_user.id
+						target      = "github"
+						identity_id = "5678"
+						details = jsonencode({
+							email = "tf_test_user_identity@test.fr"
+						})
+					}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("logto_user_identity.github", "identity_id", "5678"),
+				),
+			},
+			// Removing details keeps those already linked
+			{
+				Config: ProviderConfig + `
+					resource "logto_user" "test_user" {
+						username = "tf_test_user_identity"
+					}
+
+					resource "logto_user_identity" "github" {
+						user_id     = logto_user.test_user.id
+						target      = "github"
+						identity_id = "9012"
+					}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("logto_user_identity.github", "identity_id", "9012"),
+					resource.TestCheckResourceAttr("logto_user_identity.github", "details", `{"email":"tf_test_user_identity@test.fr"}`),
+				),
+			},
+			// Invalid details
+			{
+				Config: ProviderConfig + `
+					resource "logto_user" "test_user" {
+						username = "tf_test_user_identity"
+					}
+
+					resource "logto_user_identity" "github" {
+						user_id     = logto_user.test_user.id
+						target      = "github"
+						identity_id = "5678"
+						details     = jsonencode(["email"])
+					}
+				`,
+				ExpectError: regexp.MustCompile("details must be a JSON encoded object"),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
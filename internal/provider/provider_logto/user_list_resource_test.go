@@ -0,0 +1,74 @@
+package provider_logto
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/querycheck"
+	"github.com/hashicorp/terraform-plugin-testing/querycheck/queryfilter"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAccUserListResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		ProtoV6ProviderFactories: TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: ProviderConfig + `
+					resource "logto_user" "alice" {
+						name          = "Alice"
+						username      = "tf_list_alice"
+						primary_email = "alice@list-users.test"
+					}
+
+					resource "logto_user" "bob" {
+						username      = "tf_list_bob"
+						primary_email = "bob@list-users.test"
+					}
+				`,
+			},
+			{
+				Query: true,
+				Config: ProviderConfig + `
+					list "logto_user" "by_email" {
+						provider = logto
+
+						config {
+							primary_email = "%@list-users.test"
+						}
+					}
+
+					list "logto_user" "by_username_and_email" {
+						provider         = logto
+						include_resource = true
+
+						config {
+							username      = "TF_LIST_%"
+							primary_email = "alice@%"
+							joint_mode    = "and"
+						}
+					}
+				`,
+				QueryResultChecks: []querycheck.QueryResultCheck{
+					querycheck.ExpectLength("logto_user.by_email", 2),
+					querycheck.ExpectLength("logto_user.by_username_and_email", 1),
+					querycheck.ExpectResourceKnownValues(
+						"logto_user.by_username_and_email",
+						queryfilter.ByDisplayName(knownvalue.StringExact("tf_list_alice")),
+						[]querycheck.KnownValueCheck{
+							{
+								Path:       tfjsonpath.New("name"),
+								KnownValue: knownvalue.StringExact("Alice"),
+							},
+						},
+					),
+				},
+			},
+		},
+	})
+}
@@ -1,8 +1,12 @@
 package provider_logto
 
 import (
+	"context"
+	"fmt"
+	"regexp"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 )
 
@@ -60,7 +64,7 @@ func TestAccUserResource(t *testing.T) {
 					resource.TestCheckResourceAttr("logto_user.test_user", "name", "test_user_modified"),
 					resource.TestCheckResourceAttr("logto_user.test_user", "primary_email", "test_user@test.fr"),
 
-					resource.TestCheckResourceAttr("logto_user.test_user", "profile.%", "4"),
+					resource.TestCheckResourceAttr("logto_user.test_user", "profile.%", "12"),
 					resource.TestCheckResourceAttr("logto_user.test_user", "role_ids.#", "1"),
 
 					resource.TestCheckResourceAttr("logto_user.test_user", "profile.family_name", "test_family_name_modified"),
@@ -123,7 +127,7 @@ func TestAccUserResource(t *testing.T) {
 					resource.TestCheckResourceAttr("logto_user.test_user", "name", "test_user_modified"),
 					resource.TestCheckResourceAttr("logto_user.test_user", "username", "test_username_modified"),
 
-					resource.TestCheckResourceAttr("logto_user.test_user", "profile.%", "4"),
+					resource.TestCheckResourceAttr("logto_user.test_user", "profile.%", "12"),
 
 					resource.TestCheckResourceAttr("logto_user.test_user", "profile.family_name", "test_family_name_modified"),
 					resource.TestCheckResourceAttr("logto_user.test_user", "profile.given_name", ""),
@@ -135,3 +139,351 @@ func TestAccUserResource(t *testing.T) {
 		},
 	})
 }
+
+func TestAccUserResourceWithCustomDataKeys(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: ProviderConfig + `
+					resource "logto_user" "test_user" {
+						username         = "tf_test_custom_data"
+						custom_data_keys = ["features", "plan"]
+						custom_data = jsonencode({
+							features = ["beta"]
+							plan     = "free"
+						})
+					}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("logto_user.test_user", "custom_data", `{"features":["beta"],"plan":"free"}`),
+					resource.TestCheckResourceAttr("logto_user.test_user", "custom_data_keys.#", "2"),
+				),
+			},
+			// Removing a managed key
+			{
+				Config: ProviderConfig + `
+					resource "logto_user" "test_user" {
+						username         = "tf_test_custom_data"
+						custom_data_keys = ["features", "plan"]
+						custom_data = jsonencode({
+							features = ["beta"]
+						})
+					}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("logto_user.test_user", "custom_data", `{"features":["beta"]}`),
+				),
+			},
+			// The keys of custom_data must be managed
+			{
+				Config: ProviderConfig + `
+					resource "logto_user" "test_user" {
+						username         = "tf_test_custom_data"
+						custom_data_keys = ["features"]
+						custom_data = jsonencode({
+							plan = "free"
+						})
+					}
+				`,
+				ExpectError: regexp.MustCompile("must be listed in custom_data_keys"),
+			},
+		},
+	})
+}
+
+func TestAccUserResourceWithPassword(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: ProviderConfig + `
+					resource "logto_user" "test_user" {
+						username         = "tf_test_password"
+						password         = "s3cr3t-Passw0rd"
+						password_version = 1
+					}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckNoResourceAttr("logto_user.test_user", "password"),
+					resource.TestCheckResourceAttr("logto_user.test_user", "password_version", "1"),
+				),
+			},
+			// Changing password_version sets the password again
+			{
+				Config: ProviderConfig + `
+					resource "logto_user" "test_user" {
+						username         = "tf_test_password"
+						password         = "an0ther-Passw0rd"
+						password_version = 2
+					}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckNoResourceAttr("logto_user.test_user", "password"),
+					resource.TestCheckResourceAttr("logto_user.test_user", "password_version", "2"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:            "logto_user.test_user",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"password_version"},
+			},
+		},
+	})
+}
+
+// TestUserResourcePasswordWriteOnly makes sure that the code generator
+// marked the password as write-only, so that it is never stored in the state.
+func TestUserResourcePasswordWriteOnly(t *testing.T) {
+	server, err := TestAccProtoV6ProviderFactories["logto"]()
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp, err := server.GetProviderSchema(context.Background(), &tfprotov6.GetProviderSchemaRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, attribute := range resp.ResourceSchemas["logto_user"].Block.Attributes {
+		if attribute.Name == "password" {
+			if !attribute.WriteOnly || !attribute.Sensitive {
+				t.Fatalf("password must be write-only and sensitive, got WriteOnly=%v Sensitive=%v", attribute.WriteOnly, attribute.Sensitive)
+			}
+			return
+		}
+	}
+	t.Fatal("the password attribute of logto_user is missing")
+}
+
+func TestAccUserResourceWithPasswordDigest(t *testing.T) {
+	var id string
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: ProviderConfig + `
+					resource "logto_user" "test_user" {
+						username           = "tf_test_password_digest"
+						password_digest    = "5f4dcc3b5aa765d61d8327deb882cf99"
+						password_algorithm = "MD5"
+					}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("logto_user.test_user", "password_algorithm", "MD5"),
+					resource.TestCheckResourceAttrWith("logto_user.test_user", "id", func(value string) error {
+						id = value
+						return nil
+					}),
+				),
+			},
+			// The digest cannot be changed once the user is created
+			{
+				Config: ProviderConfig + `
+					resource "logto_user" "test_user" {
+						username           = "tf_test_password_digest"
+						password_digest    = "$2a$10$N9qo8uLOickgx2ZMRZoMyeIjZAgcfl7p92ldGxad68LJZdL17lhWy"
+						password_algorithm = "Bcrypt"
+					}
+				`,
+				ExpectError: regexp.MustCompile("Cannot change the password digest of a user"),
+			},
+			{
+				Config: ProviderConfig + `
+					resource "logto_user" "test_user" {
+						username        = "tf_test_password_digest"
+						password_digest = "5f4dcc3b5aa765d61d8327deb882cf99"
+					}
+				`,
+				ExpectError: regexp.MustCompile("password_algorithm"),
+			},
+			// The password is changed without recreating the user
+			{
+				Config: ProviderConfig + `
+					resource "logto_user" "test_user" {
+						username         = "tf_test_password_digest"
+						password         = "tf_test_Passw0rd!"
+						password_version = 1
+					}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckNoResourceAttr("logto_user.test_user", "password_digest"),
+					resource.TestCheckResourceAttr("logto_user.test_user", "has_password", "true"),
+					resource.TestCheckResourceAttrWith("logto_user.test_user", "id", func(value string) error {
+						if value != id {
+							return fmt.Errorf("the user was recreated: %s != %s", value, id)
+						}
+						return nil
+					}),
+				),
+			},
+		},
+	})
+}
+
+func TestAccUserResourceSuspended(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: ProviderConfig + `
+					resource "logto_user" "test_user" {
+						username     = "tf_test_suspended"
+						is_suspended = true
+					}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("logto_user.test_user", "is_suspended", "true"),
+					resource.TestCheckResourceAttr("logto_user.test_user", "has_password", "false"),
+					resource.TestCheckResourceAttr("logto_user.test_user", "deletion_protection", "false"),
+					resource.TestCheckResourceAttrSet("logto_user.test_user", "created_at"),
+					resource.TestCheckResourceAttrSet("logto_user.test_user", "updated_at"),
+					resource.TestCheckNoResourceAttr("logto_user.test_user", "last_sign_in_at"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "logto_user.test_user",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// ImportState testing by username
+			{
+				ResourceName:      "logto_user.test_user",
+				ImportState:       true,
+				ImportStateId:     "tf_test_suspended",
+				ImportStateVerify: true,
+			},
+			// Reinstate the user and protect it from deletion
+			{
+				Config: ProviderConfig + `
+					resource "logto_user" "test_user" {
+						username            = "tf_test_suspended"
+						deletion_protection = true
+					}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("logto_user.test_user", "is_suspended", "false"),
+					resource.TestCheckResourceAttr("logto_user.test_user", "deletion_protection", "true"),
+				),
+			},
+			// Remove the protection so that the user is deleted at the end of
+			// the test
+			{
+				Config: ProviderConfig + `
+					resource "logto_user" "test_user" {
+						username = "tf_test_suspended"
+					}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("logto_user.test_user", "deletion_protection", "false"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccUserResourceWithFullProfile(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: ProviderConfig + `
+					resource "logto_user" "test_user" {
+						username      = "tf_test_full_profile"
+						primary_phone = "+33612345678"
+						avatar        = "https://example.com/avatar.png"
+
+						profile = {
+							nickname  = "test"
+							website   = "https://example.com"
+							birthdate = "1990-01-31"
+							locale    = "fr-FR"
+							zoneinfo  = "Europe/Paris"
+
+							address = {
+								locality = "Paris"
+								country  = "France"
+							}
+						}
+					}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("logto_user.test_user", "primary_phone", "+33612345678"),
+					resource.TestCheckResourceAttr("logto_user.test_user", "avatar", "https://example.com/avatar.png"),
+					resource.TestCheckResourceAttr("logto_user.test_user", "profile.nickname", "test"),
+					resource.TestCheckResourceAttr("logto_user.test_user", "profile.birthdate", "1990-01-31"),
+					resource.TestCheckResourceAttr("logto_user.test_user", "profile.locale", "fr-FR"),
+					resource.TestCheckResourceAttr("logto_user.test_user", "profile.address.locality", "Paris"),
+					resource.TestCheckResourceAttr("logto_user.test_user", "profile.address.country", "France"),
+					resource.TestCheckNoResourceAttr("logto_user.test_user", "profile.gender"),
+					resource.TestCheckNoResourceAttr("logto_user.test_user", "profile.address.region"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "logto_user.test_user",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Removing attributes makes them null
+			{
+				Config: ProviderConfig + `
+					resource "logto_user" "test_user" {
+						username = "tf_test_full_profile"
+
+						profile = {
+							locale = "en"
+						}
+					}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckNoResourceAttr("logto_user.test_user", "primary_phone"),
+					resource.TestCheckNoResourceAttr("logto_user.test_user", "avatar"),
+					resource.TestCheckNoResourceAttr("logto_user.test_user", "profile.nickname"),
+					resource.TestCheckNoResourceAttr("logto_user.test_user", "profile.address.%"),
+					resource.TestCheckResourceAttr("logto_user.test_user", "profile.locale", "en"),
+				),
+			},
+			// Empty strings are kept as configured
+			{
+				Config: ProviderConfig + `
+					resource "logto_user" "test_user" {
+						username = "tf_test_full_profile"
+
+						profile = {
+							nickname           = ""
+							preferred_username = ""
+							locale             = "en"
+
+							address = {
+								locality = ""
+							}
+						}
+					}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("logto_user.test_user", "profile.nickname", ""),
+					resource.TestCheckResourceAttr("logto_user.test_user", "profile.preferred_username", ""),
+					resource.TestCheckResourceAttr("logto_user.test_user", "profile.address.locality", ""),
+					resource.TestCheckNoResourceAttr("logto_user.test_user", "profile.address.country"),
+				),
+			},
+			// Phone numbers must use the E.164 format
+			{
+				Config: ProviderConfig + `
+					resource "logto_user" "test_user" {
+						username      = "tf_test_full_profile"
+						primary_phone = "0612345678"
+					}
+				`,
+				ExpectError: regexp.MustCompile("E.164"),
+			},
+		},
+	})
+}
@@ -0,0 +1,163 @@
+package provider_logto
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccUserRolesResource(t *testing.T) {
+	config := ProviderConfig + `
+		resource "logto_role" "editor" {
+			name        = "tf_test_user_roles_editor"
+			description = "Editor"
+		}
+
+		resource "logto_role" "viewer" {
+			name        = "tf_test_user_roles_viewer"
+			description = "Viewer"
+		}
+
+		resource "logto_user" "test_user" {
+			username = "tf_test_user_roles"
+		}
+	`
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing, each additive resource only sees its
+			// own roles
+			{
+				Config: config + `
+					resource "logto_user_roles" "editor" {
+						user_id  = logto_user.test_user.id
+						mode     = "additive"
+						role_ids = [logto_role.editor.id]
+					}
+
+					resource "logto_user_roles" "viewer" {
+						user_id  = logto_user.test_user.id
+						mode     = "additive"
+						role_ids = [logto_role.viewer.id]
+					}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("logto_user_roles.editor", "id", "logto_user.test_user", "id"),
+					resource.TestCheckResourceAttr("logto_user_roles.editor", "mode", "additive"),
+					resource.TestCheckResourceAttr("logto_user_roles.editor", "role_ids.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair("logto_user_roles.editor", "role_ids.*", "logto_role.editor", "id"),
+					resource.TestCheckResourceAttr("logto_user_roles.viewer", "role_ids.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair("logto_user_roles.viewer", "role_ids.*", "logto_role.viewer", "id"),
+				),
+			},
+			// The authoritative mode removes the roles that are not listed
+			{
+				Config: config + `
+					resource "logto_user_roles" "editor" {
+						user_id  = logto_user.test_user.id
+						role_ids = [logto_role.editor.id]
+					}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("logto_user_roles.editor", "mode", "authoritative"),
+					resource.TestCheckResourceAttr("logto_user_roles.editor", "role_ids.#", "1"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "logto_user_roles.editor",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: config + `
+					resource "logto_user_roles" "editor" {
+						user_id  = logto_user.test_user.id
+						role_ids = [logto_role.editor.id, logto_role.viewer.id]
+					}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("logto_user_roles.editor", "role_ids.#", "2"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccUserRolesResourceImportAdditive(t *testing.T) {
+	config := ProviderConfig + `
+		resource "logto_role" "editor" {
+			name        = "tf_test_user_roles_import_editor"
+			description = "Editor"
+		}
+
+		resource "logto_role" "viewer" {
+			name        = "tf_test_user_roles_import_viewer"
+			description = "Viewer"
+		}
+
+		resource "logto_user" "test_user" {
+			username = "tf_test_user_roles_import"
+		}
+
+		resource "logto_user_roles" "viewer" {
+			user_id  = logto_user.test_user.id
+			mode     = "additive"
+			role_ids = [logto_role.viewer.id]
+		}
+	`
+	editor := `
+		resource "logto_user_roles" "editor" {
+			user_id  = logto_user.test_user.id
+			mode     = "additive"
+			role_ids = [logto_role.editor.id]
+		}
+	`
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config + editor,
+			},
+			// Forget the editor role assignment without removing it
+			{
+				Config: config + `
+					removed {
+						from = logto_user_roles.editor
+
+						lifecycle {
+							destroy = false
+						}
+					}
+				`,
+			},
+			// The imported resource holds both roles of the user
+			{
+				Config:             config + editor,
+				ResourceName:       "logto_user_roles.editor",
+				ImportState:        true,
+				ImportStatePersist: true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					return s.RootModule().Resources["logto_user.test_user"].Primary.ID, nil
+				},
+			},
+			// Switching it to the additive mode keeps the viewer role, the
+			// viewer assignment would show a drift otherwise
+			{
+				Config: config + editor,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("logto_user_roles.editor", "mode", "additive"),
+					resource.TestCheckResourceAttr("logto_user_roles.editor", "role_ids.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair("logto_user_roles.editor", "role_ids.*", "logto_role.editor", "id"),
+					resource.TestCheckResourceAttr("logto_user_roles.viewer", "role_ids.#", "1"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
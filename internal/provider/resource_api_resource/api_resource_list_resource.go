@@ -0,0 +1,77 @@
+package resource_api_resource
+
+import (
+	"context"
+	"strings"
+
+	"github.com/Lenstra/terraform-provider-logto/client"
+	"github.com/Lenstra/terraform-provider-logto/internal/provider/identity"
+	"github.com/Lenstra/terraform-provider-logto/internal/provider/listing"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+var (
+	_ list.ListResourceWithConfigure = &apiResourceListResource{}
+)
+
+type apiResourceListResource struct {
+	client *client.Client
+}
+
+func ApiResourceListResource() list.ListResource {
+	return &apiResourceListResource{}
+}
+
+func (r *apiResourceListResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_api_resource"
+}
+
+func (r *apiResourceListResource) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description:         "Lists the API resources of the tenant, except the Logto Management API.",
+		MarkdownDescription: "Lists the API resources of the tenant, except the Logto Management API.",
+	}
+}
+
+func (r *apiResourceListResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		return
+	}
+	r.client = client
+}
+
+func (r *apiResourceListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	// The Logto Management API is built into every tenant and cannot be
+	// managed.
+	apiResources := func(yield func(client.ApiResourceModel, error) bool) {
+		for apiResource, err := range r.client.ApiResourcesAll(ctx, nil) {
+			if err == nil && strings.HasSuffix(apiResource.Indicator, ".logto.app/api") {
+				continue
+			}
+			if !yield(apiResource, err) {
+				return
+			}
+		}
+	}
+
+	stream.Results = listing.Results(ctx, req, apiResources, "Error listing api_resources", func(apiResource client.ApiResourceModel, result *list.ListResult) {
+		result.DisplayName = apiResource.Name
+		result.Diagnostics.Append(identity.Set(ctx, result.Identity, apiResource.ID)...)
+		if !req.IncludeResource {
+			return
+		}
+
+		var model ApiResourceModel
+		result.Diagnostics.Append(convertToTerraformModel(ctx, &apiResource, &model)...)
+		if result.Diagnostics.HasError() {
+			return
+		}
+		result.Diagnostics.Append(result.Resource.Set(ctx, model)...)
+	})
+}
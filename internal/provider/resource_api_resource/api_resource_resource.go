@@ -5,6 +5,7 @@ import (
 	"math/big"
 
 	"github.com/Lenstra/terraform-provider-logto/client"
+	"github.com/Lenstra/terraform-provider-logto/internal/provider/identity"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -12,6 +13,10 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 )
 
+var (
+	_ resource.ResourceWithIdentity = &apiResourceResource{}
+)
+
 func (r *apiResourceResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var plan, state ApiResourceModel
 	diags := req.Plan.Get(ctx, &plan)
@@ -31,6 +36,7 @@ func (r *apiResourceResource) Create(ctx context.Context, req resource.CreateReq
 		resp.Diagnostics.AddError("Error creating api_resource", err.Error())
 		return
 	}
+	resp.Diagnostics.Append(identity.Set(ctx, resp.Identity, apiResource.ID)...)
 
 	diags = convertToTerraformModel(ctx, apiResource, &state)
 	resp.Diagnostics.Append(diags...)
@@ -49,6 +55,7 @@ func (r *apiResourceResource) Read(ctx context.Context, req resource.ReadRequest
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	resp.Diagnostics.Append(identity.Set(ctx, resp.Identity, state.Id.ValueString())...)
 
 	apiResource, err := r.client.ApiResourceGet(ctx, state.Id.ValueString())
 	if err != nil {
@@ -96,6 +103,7 @@ func (r *apiResourceResource) Update(ctx context.Context, req resource.UpdateReq
 		resp.Diagnostics.AddError("Error updating api_resource", err.Error())
 		return
 	}
+	resp.Diagnostics.Append(identity.Set(ctx, resp.Identity, apiResource.ID)...)
 
 	diags = convertToTerraformModel(ctx, apiResource, &state)
 	resp.Diagnostics.Append(diags...)
@@ -121,6 +129,10 @@ func (r *apiResourceResource) Delete(ctx context.Context, req resource.DeleteReq
 	}
 }
 
+func (r *apiResourceResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identity.Schema()
+}
+
 func decodePlan(ctx context.Context, plan ApiResourceModel) (*client.ApiResourceModel, diag.Diagnostics) {
 	var diags diag.Diagnostics
 
@@ -2,7 +2,9 @@ package resource_api_resource_scope
 
 import (
 	"context"
+	"fmt"
 	"math/big"
+	"slices"
 	"strings"
 
 	"github.com/Lenstra/terraform-provider-logto/client"
@@ -56,19 +58,12 @@ func (r *apiResourceScopeResource) Read(ctx context.Context, req resource.ReadRe
 		return
 	}
 
-	queryParams := map[string]string{
-		"page":      "1",
-		"page_size": "20",
-	}
-
-	resourceScopes, err := r.client.ApiResourceScopesList(ctx, state.ResourceId.ValueString(), queryParams)
-	if err != nil {
-		resp.Diagnostics.AddError("Error reading api_resource_scopes", err.Error())
-		return
-	}
-
 	var foundScope *client.ScopeModel
-	for _, scope := range resourceScopes {
+	for scope, err := range r.client.ApiResourceScopesAll(ctx, state.ResourceId.ValueString(), nil) {
+		if err != nil {
+			resp.Diagnostics.AddError("Error reading api_resource_scopes", err.Error())
+			return
+		}
 		if scope.ID == state.Id.ValueString() {
 			foundScope = &scope
 			break
@@ -164,16 +159,71 @@ func convertToTerraformModel(apiResourceScope *client.ScopeModel, model *ApiReso
 	}
 }
 
+// ImportState accepts either the identifiers of the API resource and of the
+// scope, or the indicator of the API resource and the name of the scope.
+// Identifiers generated by Logto never contain a colon while indicators always
+// do, which tells the two formats apart.
 func (r *apiResourceScopeResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	parts := strings.Split(req.ID, "/")
-	if len(parts) != 2 {
-		resp.Diagnostics.AddError(
-			"Unexpected import identifier",
-			"Expected format: <resource_id>/<scope_id>",
-		)
-		return
+	resourceId, scopeId := "", ""
+	if strings.Contains(req.ID, ":") {
+		scope, err := r.findByIndicatorAndName(ctx, req.ID)
+		if err != nil {
+			resp.Diagnostics.AddError("Error reading api_resource_scopes", err.Error())
+			return
+		}
+		if scope == nil {
+			resp.Diagnostics.AddError(
+				"Cannot import api_resource_scope",
+				fmt.Sprintf("No scope matches %q, it must be the indicator of an API resource followed by a colon and the name of one of its scopes.", req.ID),
+			)
+			return
+		}
+		resourceId, scopeId = scope.ResourceId, scope.ID
+	} else {
+		parts := strings.Split(req.ID, "/")
+		if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+			resp.Diagnostics.AddError(
+				"Unexpected import identifier",
+				"Expected format: <resource_id>/<scope_id> or <indicator>:<scope_name>",
+			)
+			return
+		}
+		resourceId, scopeId = parts[0], parts[1]
 	}
 
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("resource_id"), parts[0])...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), parts[1])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("resource_id"), resourceId)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), scopeId)...)
+}
+
+// findByIndicatorAndName returns the scope identified by id, in the
+// <indicator>:<scope_name> format, or nil if there is none. As both the
+// indicator and the name may contain colons, every API resource whose
+// indicator is a prefix of id is tried, the longest first.
+func (r *apiResourceScopeResource) findByIndicatorAndName(ctx context.Context, id string) (*client.ScopeModel, error) {
+	var candidates []client.ApiResourceModel
+	for apiResource, err := range r.client.ApiResourcesAll(ctx, nil) {
+		if err != nil {
+			return nil, err
+		}
+		if apiResource.Indicator != "" && strings.HasPrefix(id, apiResource.Indicator+":") {
+			candidates = append(candidates, apiResource)
+		}
+	}
+	slices.SortFunc(candidates, func(a, b client.ApiResourceModel) int {
+		return len(b.Indicator) - len(a.Indicator)
+	})
+
+	for _, apiResource := range candidates {
+		name := strings.TrimPrefix(id, apiResource.Indicator+":")
+		for scope, err := range r.client.ApiResourceScopesAll(ctx, apiResource.ID, map[string]string{"search": name}) {
+			if err != nil {
+				return nil, err
+			}
+			if scope.Name == name {
+				scope.ResourceId = apiResource.ID
+				return &scope, nil
+			}
+		}
+	}
+	return nil, nil
 }
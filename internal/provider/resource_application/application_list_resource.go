@@ -0,0 +1,117 @@
+package resource_application
+
+import (
+	"context"
+
+	"github.com/Lenstra/terraform-provider-logto/client"
+	"github.com/Lenstra/terraform-provider-logto/internal/provider/identity"
+	"github.com/Lenstra/terraform-provider-logto/internal/provider/listing"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ list.ListResourceWithConfigure = &applicationListResource{}
+)
+
+type applicationListResource struct {
+	client *client.Client
+}
+
+func ApplicationListResource() list.ListResource {
+	return &applicationListResource{}
+}
+
+type ApplicationListModel struct {
+	Search       types.String `tfsdk:"search"`
+	Type         types.String `tfsdk:"type"`
+	IsThirdParty types.Bool   `tfsdk:"is_third_party"`
+}
+
+func (r *applicationListResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_application"
+}
+
+func (r *applicationListResource) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description:         "Lists the applications of the tenant.",
+		MarkdownDescription: "Lists the applications of the tenant.",
+		Attributes: map[string]schema.Attribute{
+			"search": schema.StringAttribute{
+				Optional:            true,
+				Description:         "Only list the applications whose identifier, name or description matches this value, where % matches any sequence of characters and _ any single character.",
+				MarkdownDescription: "Only list the applications whose identifier, name or description matches this value, where `%` matches any sequence of characters and `_` any single character.",
+			},
+			"type": schema.StringAttribute{
+				Optional:            true,
+				Description:         "Only list the applications of this type.",
+				MarkdownDescription: "Only list the applications of this type.",
+				Validators: []validator.String{
+					stringvalidator.OneOf(
+						"Native",
+						"SPA",
+						"Traditional",
+						"MachineToMachine",
+						"Protected",
+						"SAML",
+					),
+				},
+			},
+			"is_third_party": schema.BoolAttribute{
+				Optional:            true,
+				Description:         "Only list the third-party applications when true, or the first-party ones when false.",
+				MarkdownDescription: "Only list the third-party applications when `true`, or the first-party ones when `false`.",
+			},
+		},
+	}
+}
+
+func (r *applicationListResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		return
+	}
+	r.client = client
+}
+
+func (r *applicationListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var config ApplicationListModel
+	diags := req.Config.Get(ctx, &config)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	params := map[string]string{}
+	if !config.Search.IsNull() {
+		params["search"] = config.Search.ValueString()
+	}
+	if !config.Type.IsNull() {
+		params["types"] = config.Type.ValueString()
+	}
+	if !config.IsThirdParty.IsNull() {
+		params["isThirdParty"] = config.IsThirdParty.String()
+	}
+
+	stream.Results = listing.Results(ctx, req, r.client.ApplicationsAll(ctx, params), "Error listing applications", func(app client.ApplicationModel, result *list.ListResult) {
+		result.DisplayName = app.Name
+		result.Diagnostics.Append(identity.Set(ctx, result.Identity, app.ID)...)
+		if !req.IncludeResource {
+			return
+		}
+
+		var model ApplicationModel
+		result.Diagnostics.Append(convertToTerraformModel(ctx, &app, nil, types.SetNull(types.StringType), &model)...)
+		if result.Diagnostics.HasError() {
+			return
+		}
+		result.Diagnostics.Append(result.Resource.Set(ctx, model)...)
+	})
+}
@@ -2,15 +2,36 @@ package resource_application
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
 
 	"github.com/Lenstra/terraform-provider-logto/client"
+	"github.com/Lenstra/terraform-provider-logto/internal/provider/customdata"
+	"github.com/Lenstra/terraform-provider-logto/internal/provider/identity"
+	"github.com/Lenstra/terraform-provider-logto/internal/provider/roleconflicts"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 )
 
+var (
+	_ resource.ResourceWithModifyPlan     = &applicationResource{}
+	_ resource.ResourceWithValidateConfig = &applicationResource{}
+	_ resource.ResourceWithIdentity       = &applicationResource{}
+)
+
+// The values used by Logto when they are missing from the custom client
+// metadata of an application.
+const (
+	defaultIdTokenTtl            = 3600
+	defaultRefreshTokenTtlInDays = 14
+	defaultRotateRefreshToken    = true
+)
+
 func (r *applicationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var plan, state ApplicationModel
 	diags := req.Plan.Get(ctx, &plan)
@@ -19,14 +40,63 @@ func (r *applicationResource) Create(ctx context.Context, req resource.CreateReq
 		return
 	}
 
-	application := decodePlan(ctx, plan)
+	application, roleIds, diags := decodePlan(ctx, plan, nil)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	application, err := r.client.ApplicationCreate(ctx, application)
 	if err != nil {
 		resp.Diagnostics.AddError("Error creating application", err.Error())
 		return
 	}
+	resp.Diagnostics.Append(identity.Set(ctx, resp.Identity, application.ID)...)
+
+	// Put the application into the state before assigning roles in case of error during roles assignment
+	diags = convertToTerraformModel(ctx, application, nil, plan.CustomDataKeys, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !plan.ProtectedApp.IsNull() {
+		// Logto only uses the sub domain and the origin when creating a
+		// protected app, the rest of its configuration must be updated
+		// afterwards.
+		application.ProtectedAppMetadata, diags = decodeProtectedApp(ctx, plan.ProtectedApp)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		application.ProtectedAppMetadata.SubDomain = ""
+		application, err = r.client.ApplicationUpdate(ctx, application)
+		if err != nil {
+			resp.Diagnostics.AddError("Error updating protected app", err.Error())
+			return
+		}
+
+		application, diags = r.updateCustomDomains(ctx, application, plan.ProtectedApp)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	if roleIds != nil && len(roleIds.RoleIds) != 0 {
+		err = r.client.AssignRolesForApplication(ctx, roleIds, application.ID)
+		if err != nil {
+			resp.Diagnostics.AddError("Error during assignation of role(s) for application", err.Error())
+			return
+		}
+	}
 
-	diags = convertToTerraformModel(ctx, application, &state)
+	diags = convertToTerraformModel(ctx, application, roleIds, plan.CustomDataKeys, &state)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -42,6 +112,7 @@ func (r *applicationResource) Read(ctx context.Context, req resource.ReadRequest
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	resp.Diagnostics.Append(identity.Set(ctx, resp.Identity, state.Id.ValueString())...)
 
 	application, err := r.client.ApplicationGet(ctx, state.Id.ValueString())
 	if err != nil {
@@ -54,7 +125,21 @@ func (r *applicationResource) Read(ctx context.Context, req resource.ReadRequest
 		return
 	}
 
-	diags = convertToTerraformModel(ctx, application, &state)
+	var roleIds *client.RoleIdsModel
+	if !state.RoleIds.IsNull() && !state.RoleIds.IsUnknown() {
+		roles, err := r.client.GetRolesForApplication(ctx, application.ID)
+		if err != nil {
+			resp.Diagnostics.AddError("Error reading role(s) of application", err.Error())
+			return
+		}
+
+		roleIds = &client.RoleIdsModel{RoleIds: []string{}}
+		for _, role := range roles {
+			roleIds.RoleIds = append(roleIds.RoleIds, role.ID)
+		}
+	}
+
+	diags = convertToTerraformModel(ctx, application, roleIds, state.CustomDataKeys, &state)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -70,15 +155,54 @@ func (r *applicationResource) Update(ctx context.Context, req resource.UpdateReq
 	if resp.Diagnostics.HasError() {
 		return
 	}
-	application := decodePlan(ctx, plan)
+
+	var current json.RawMessage
+	if !plan.CustomDataKeys.IsNull() {
+		application, err := r.client.ApplicationGet(ctx, plan.Id.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("Error reading application", err.Error())
+			return
+		}
+		if application != nil {
+			current = application.CustomData
+		}
+	}
+
+	application, roleIds, diags := decodePlan(ctx, plan, current)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if application.ProtectedAppMetadata != nil {
+		// The sub domain of a protected app cannot be changed once created.
+		application.ProtectedAppMetadata.SubDomain = ""
+	}
 
 	application, err := r.client.ApplicationUpdate(ctx, application)
 	if err != nil {
 		resp.Diagnostics.AddError("Error updating application", err.Error())
 		return
 	}
+	resp.Diagnostics.Append(identity.Set(ctx, resp.Identity, application.ID)...)
 
-	diags = convertToTerraformModel(ctx, application, &state)
+	if !plan.ProtectedApp.IsNull() {
+		application, diags = r.updateCustomDomains(ctx, application, plan.ProtectedApp)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	if roleIds != nil {
+		err = r.client.UpdateRolesForApplication(ctx, roleIds, application.ID)
+		if err != nil {
+			resp.Diagnostics.AddError("Error updating role(s) of application", err.Error())
+			return
+		}
+	}
+
+	diags = convertToTerraformModel(ctx, application, roleIds, plan.CustomDataKeys, &state)
 	resp.Diagnostics.Append(diags...)
 	if diags.HasError() {
 		return
@@ -101,27 +225,134 @@ func (r *applicationResource) Delete(ctx context.Context, req resource.DeleteReq
 	}
 }
 
-func decodePlan(ctx context.Context, plan ApplicationModel) *client.ApplicationModel {
+func (r *applicationResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identity.Schema()
+}
+
+// decodePlan returns the application to send to Logto, current holds its
+// custom data when only some of its keys are managed by Terraform.
+func decodePlan(ctx context.Context, plan ApplicationModel, current json.RawMessage) (*client.ApplicationModel, *client.RoleIdsModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
 	model := &client.ApplicationModel{
-		ID:                 plan.Id.ValueString(),
-		Name:               plan.Name.ValueString(),
-		Type:               plan.Type.ValueString(),
-		Description:        plan.Description.ValueString(),
-		IsThirdParty:       plan.IsThirdParty.ValueBool(),
-		OidcClientMetadata: &client.OidcClientMetadata{},
+		ID:           plan.Id.ValueString(),
+		Name:         plan.Name.ValueString(),
+		Type:         plan.Type.ValueString(),
+		Description:  plan.Description.ValueString(),
+		IsThirdParty: plan.IsThirdParty.ValueBool(),
+		OidcClientMetadata: &client.OidcClientMetadata{
+			BackchannelLogoutUri:             plan.BackchannelLogoutUri.ValueString(),
+			BackchannelLogoutSessionRequired: plan.BackchannelLogoutSessionRequired.ValueBoolPointer(),
+			LogoUri:                          plan.LogoUri.ValueString(),
+		},
+		CustomClientMetadata: &client.CustomClientMetadata{
+			IdTokenTtl:              float64Pointer(plan.IdTokenTtl),
+			RefreshTokenTtlInDays:   float64Pointer(plan.RefreshTokenTtlInDays),
+			AlwaysIssueRefreshToken: plan.AlwaysIssueRefreshToken.ValueBoolPointer(),
+			RotateRefreshToken:      plan.RotateRefreshToken.ValueBoolPointer(),
+		},
 	}
 	plan.RedirectUris.ElementsAs(ctx, &model.OidcClientMetadata.RedirectUris, true)
 	plan.PostLogoutRedirectUris.ElementsAs(ctx, &model.OidcClientMetadata.PostLogoutRedirectUris, true)
 
 	if !plan.CorsAllowedOrigins.IsNull() {
-		model.CustomClientMetadata = &client.CustomClientMetadata{}
 		plan.CorsAllowedOrigins.ElementsAs(ctx, &model.CustomClientMetadata.CorsAllowedOrigins, true)
 	}
 
-	return model
+	var d diag.Diagnostics
+	model.CustomData, d = customdata.Expand(ctx, plan.CustomData, plan.CustomDataKeys, current)
+	diags.Append(d...)
+
+	if !plan.ProtectedApp.IsNull() && !plan.ProtectedApp.IsUnknown() {
+		model.ProtectedAppMetadata, d = decodeProtectedApp(ctx, plan.ProtectedApp)
+		diags.Append(d...)
+
+		// The redirect URIs of a protected app are managed by Logto unless
+		// they are explicitly configured.
+		if plan.RedirectUris.IsUnknown() || plan.PostLogoutRedirectUris.IsUnknown() {
+			model.OidcClientMetadata = nil
+		}
+	}
+
+	var roleIds *client.RoleIdsModel
+	if !plan.RoleIds.IsNull() && !plan.RoleIds.IsUnknown() {
+		roleIds = &client.RoleIdsModel{RoleIds: []string{}}
+		diags.Append(plan.RoleIds.ElementsAs(ctx, &roleIds.RoleIds, false)...)
+	}
+
+	return model, roleIds, diags
+}
+
+func decodeProtectedApp(ctx context.Context, value ProtectedAppValue) (*client.ProtectedAppMetadata, diag.Diagnostics) {
+	metadata := &client.ProtectedAppMetadata{
+		SubDomain:       value.SubDomain.ValueString(),
+		Origin:          value.Origin.ValueString(),
+		SessionDuration: float64(value.SessionDuration.ValueInt64()),
+		PageRules:       &[]client.PageRule{},
+	}
+
+	var paths []string
+	diags := value.PageRules.ElementsAs(ctx, &paths, true)
+	for _, path := range paths {
+		*metadata.PageRules = append(*metadata.PageRules, client.PageRule{Path: path})
+	}
+
+	return metadata, diags
+}
+
+// updateCustomDomains adds and removes the custom domains of a protected app
+// so that they match the plan, and returns the up to date application.
+func (r *applicationResource) updateCustomDomains(ctx context.Context, app *client.ApplicationModel, value ProtectedAppValue) (*client.ApplicationModel, diag.Diagnostics) {
+	var domains []string
+	diags := value.CustomDomains.ElementsAs(ctx, &domains, true)
+	if diags.HasError() {
+		return app, diags
+	}
+
+	existing := map[string]bool{}
+	if app.ProtectedAppMetadata != nil {
+		for _, domain := range app.ProtectedAppMetadata.CustomDomains {
+			existing[domain.Domain] = true
+		}
+	}
+
+	changed := false
+	for _, domain := range domains {
+		if existing[domain] {
+			delete(existing, domain)
+			continue
+		}
+		if err := r.client.ApplicationCustomDomainAdd(ctx, app.ID, domain); err != nil {
+			diags.AddError("Error adding custom domain to protected app", err.Error())
+			return app, diags
+		}
+		changed = true
+	}
+	for domain := range existing {
+		if err := r.client.ApplicationCustomDomainRemove(ctx, app.ID, domain); err != nil {
+			diags.AddError("Error removing custom domain from protected app", err.Error())
+			return app, diags
+		}
+		changed = true
+	}
+
+	if !changed {
+		return app, diags
+	}
+
+	updated, err := r.client.ApplicationGet(ctx, app.ID)
+	if err != nil {
+		diags.AddError("Error reading application", err.Error())
+		return app, diags
+	}
+	if updated == nil {
+		diags.AddError("Error reading application", fmt.Sprintf("application %q not found", app.ID))
+		return app, diags
+	}
+	return updated, diags
 }
 
-func convertToTerraformModel(ctx context.Context, app *client.ApplicationModel, model *ApplicationModel) (diags diag.Diagnostics) {
+func convertToTerraformModel(ctx context.Context, app *client.ApplicationModel, roleIds *client.RoleIdsModel, customDataKeys types.Set, model *ApplicationModel) (diags diag.Diagnostics) {
 	*model = ApplicationModel{
 		Id:           types.StringValue(app.ID),
 		TenantId:     types.StringValue(app.TenantId),
@@ -130,6 +361,26 @@ func convertToTerraformModel(ctx context.Context, app *client.ApplicationModel,
 		Type:         types.StringValue(app.Type),
 		IsThirdParty: types.BoolValue(app.IsThirdParty),
 		IsAdmin:      types.BoolValue(app.IsAdmin),
+		RoleIds:      types.SetNull(types.StringType),
+		ProtectedApp: NewProtectedAppValueNull(),
+
+		CustomDataKeys: customDataKeys,
+
+		BackchannelLogoutUri:             types.StringNull(),
+		BackchannelLogoutSessionRequired: types.BoolValue(false),
+		LogoUri:                          types.StringNull(),
+
+		IdTokenTtl:              types.Int64Value(defaultIdTokenTtl),
+		RefreshTokenTtlInDays:   types.Int64Value(defaultRefreshTokenTtlInDays),
+		AlwaysIssueRefreshToken: types.BoolValue(false),
+		RotateRefreshToken:      types.BoolValue(defaultRotateRefreshToken),
+	}
+
+	if roleIds != nil {
+		model.RoleIds, diags = types.SetValueFrom(ctx, types.StringType, roleIds.RoleIds)
+		if diags.HasError() {
+			return
+		}
 	}
 
 	if app.OidcClientMetadata != nil {
@@ -141,20 +392,184 @@ func convertToTerraformModel(ctx context.Context, app *client.ApplicationModel,
 		if diags.HasError() {
 			return
 		}
+
+		if app.OidcClientMetadata.BackchannelLogoutUri != "" {
+			model.BackchannelLogoutUri = types.StringValue(app.OidcClientMetadata.BackchannelLogoutUri)
+		}
+		if app.OidcClientMetadata.BackchannelLogoutSessionRequired != nil {
+			model.BackchannelLogoutSessionRequired = types.BoolPointerValue(app.OidcClientMetadata.BackchannelLogoutSessionRequired)
+		}
+		if app.OidcClientMetadata.LogoUri != "" {
+			model.LogoUri = types.StringValue(app.OidcClientMetadata.LogoUri)
+		}
 	}
 
 	var corsAllowedOrigins []string
-	if app.CustomClientMetadata != nil {
-		corsAllowedOrigins = app.CustomClientMetadata.CorsAllowedOrigins
+	if metadata := app.CustomClientMetadata; metadata != nil {
+		corsAllowedOrigins = metadata.CorsAllowedOrigins
+
+		if metadata.IdTokenTtl != nil {
+			model.IdTokenTtl = types.Int64Value(int64(*metadata.IdTokenTtl))
+		}
+		if metadata.RefreshTokenTtlInDays != nil {
+			model.RefreshTokenTtlInDays = types.Int64Value(int64(*metadata.RefreshTokenTtlInDays))
+		}
+		if metadata.AlwaysIssueRefreshToken != nil {
+			model.AlwaysIssueRefreshToken = types.BoolPointerValue(metadata.AlwaysIssueRefreshToken)
+		}
+		if metadata.RotateRefreshToken != nil {
+			model.RotateRefreshToken = types.BoolPointerValue(metadata.RotateRefreshToken)
+		}
 	}
 	model.CorsAllowedOrigins, diags = convertList(ctx, types.StringType, corsAllowedOrigins)
 	if diags.HasError() {
 		return
 	}
 
+	model.CustomData, diags = customdata.Flatten(ctx, app.CustomData, customDataKeys)
+	if diags.HasError() {
+		return
+	}
+
+	if metadata := app.ProtectedAppMetadata; metadata != nil {
+		model.ProtectedApp, diags = convertProtectedApp(ctx, metadata)
+	}
+
 	return
 }
 
+func convertProtectedApp(ctx context.Context, metadata *client.ProtectedAppMetadata) (ProtectedAppValue, diag.Diagnostics) {
+	var paths, domains []string
+	if metadata.PageRules != nil {
+		for _, rule := range *metadata.PageRules {
+			paths = append(paths, rule.Path)
+		}
+	}
+	for _, domain := range metadata.CustomDomains {
+		domains = append(domains, domain.Domain)
+	}
+
+	pageRules, diags := convertList(ctx, types.StringType, paths)
+	if diags.HasError() {
+		return NewProtectedAppValueNull(), diags
+	}
+	if domains == nil {
+		domains = []string{}
+	}
+	customDomains, d := types.SetValueFrom(ctx, types.StringType, domains)
+	diags.Append(d...)
+	if diags.HasError() {
+		return NewProtectedAppValueNull(), diags
+	}
+
+	// Logto does not return the sub domain, it is the first label of the
+	// host the app is served at.
+	subDomain, _, _ := strings.Cut(metadata.Host, ".")
+
+	return ProtectedAppValue{
+		CustomDomains:   customDomains,
+		Host:            types.StringValue(metadata.Host),
+		Origin:          types.StringValue(metadata.Origin),
+		PageRules:       pageRules,
+		SessionDuration: types.Int64Value(int64(metadata.SessionDuration)),
+		SubDomain:       types.StringValue(subDomain),
+		state:           attr.ValueStateKnown,
+	}, diags
+}
+
+// ValidateConfig makes sure role_ids is only used with machine-to-machine
+// applications, as Logto refuses to assign roles to other applications.
+func (r *applicationResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config ApplicationModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(customdata.Validate(ctx, config.CustomData, config.CustomDataKeys)...)
+
+	if config.Type.IsUnknown() || config.Type.IsNull() {
+		return
+	}
+
+	if !config.RoleIds.IsNull() && config.Type.ValueString() != "MachineToMachine" {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("role_ids"),
+			"Invalid role_ids",
+			fmt.Sprintf("role_ids can only be set for MachineToMachine applications, got an application of type %q.", config.Type.ValueString()),
+		)
+	}
+
+	protected := config.Type.ValueString() == "Protected"
+	if !config.ProtectedApp.IsNull() && !protected {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("protected_app"),
+			"Invalid protected_app",
+			fmt.Sprintf("protected_app can only be set for Protected applications, got an application of type %q.", config.Type.ValueString()),
+		)
+	}
+	if config.ProtectedApp.IsNull() && protected {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("protected_app"),
+			"Missing protected_app",
+			"protected_app must be set for Protected applications.",
+		)
+	}
+}
+
+// ModifyPlan lets Logto manage the redirect URIs of protected apps when they
+// are not configured, instead of planning empty lists. It also warns about the
+// roles of role_ids that are also managed by another resource.
+func (r *applicationResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan ApplicationModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(roleconflicts.ClaimPlanned(ctx, r.client, "the role_ids attribute of logto_application", roleconflicts.KindApplication, plan.Id, plan.RoleIds)...)
+
+	var config ApplicationModel
+	diags = req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() || config.Type.ValueString() != "Protected" {
+		return
+	}
+
+	// Keep the URIs already known for existing applications.
+	redirectUris := types.ListUnknown(types.StringType)
+	postLogoutRedirectUris := types.ListUnknown(types.StringType)
+	if !req.State.Raw.IsNull() {
+		var state ApplicationModel
+		diags = req.State.Get(ctx, &state)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		redirectUris, postLogoutRedirectUris = state.RedirectUris, state.PostLogoutRedirectUris
+	}
+
+	if config.RedirectUris.IsNull() {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("redirect_uris"), redirectUris)...)
+	}
+	if config.PostLogoutRedirectUris.IsNull() {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("post_logout_redirect_uris"), postLogoutRedirectUris)...)
+	}
+}
+
+func float64Pointer(value types.Int64) *float64 {
+	if value.IsNull() || value.IsUnknown() {
+		return nil
+	}
+	f := float64(value.ValueInt64())
+	return &f
+}
+
 func convertList[E any](ctx context.Context, elementType attr.Type, list []E) (basetypes.ListValue, diag.Diagnostics) {
 	if len(list) == 0 {
 		return basetypes.NewListValueFrom(ctx, elementType, []attr.Value{})
@@ -4,12 +4,24 @@ package resource_application
 
 import (
 	"context"
+	"fmt"
 	"github.com/Lenstra/terraform-provider-logto/internal/provider/planmodifiers/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"regexp"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 )
@@ -17,6 +29,28 @@ import (
 func ApplicationResourceSchema(ctx context.Context) schema.Schema {
 	return schema.Schema{
 		Attributes: map[string]schema.Attribute{
+			"always_issue_refresh_token": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Description:         "Whether a refresh token is issued even when the `offline_access` scope was not granted.",
+				MarkdownDescription: "Whether a refresh token is issued even when the `offline_access` scope was not granted.",
+				Default:             booldefault.StaticBool(false),
+			},
+			"backchannel_logout_session_required": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Description:         "Whether the `sid` claim must be included in the logout token sent to `backchannel_logout_uri`.",
+				MarkdownDescription: "Whether the `sid` claim must be included in the logout token sent to `backchannel_logout_uri`.",
+				Default:             booldefault.StaticBool(false),
+			},
+			"backchannel_logout_uri": schema.StringAttribute{
+				Optional:            true,
+				Description:         "The URI Logto calls to notify the application that a user signed out, as described by OpenID Connect Back-Channel Logout.",
+				MarkdownDescription: "The URI Logto calls to notify the application that a user signed out, as described by OpenID Connect Back-Channel Logout.",
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexp.MustCompile(`^https?://`), "must be an http or https URL"),
+				},
+			},
 			"cors_allowed_origins": schema.ListAttribute{
 				ElementType: types.StringType,
 				Optional:    true,
@@ -25,6 +59,19 @@ func ApplicationResourceSchema(ctx context.Context) schema.Schema {
 					listplanmodifier.NullIsEmpty(),
 				},
 			},
+			"custom_data": schema.StringAttribute{
+				CustomType:          jsontypes.NormalizedType{},
+				Optional:            true,
+				Computed:            true,
+				Description:         "A JSON encoded object holding arbitrary data about the application.",
+				MarkdownDescription: "A JSON encoded object holding arbitrary data about the application.",
+			},
+			"custom_data_keys": schema.SetAttribute{
+				ElementType:         types.StringType,
+				Optional:            true,
+				Description:         "The top-level keys of `custom_data` managed by Terraform. When set, the other keys are left untouched so that they can be written by other clients, and the listed keys missing from `custom_data` are removed.",
+				MarkdownDescription: "The top-level keys of `custom_data` managed by Terraform. When set, the other keys are left untouched so that they can be written by other clients, and the listed keys missing from `custom_data` are removed.",
+			},
 			"description": schema.StringAttribute{
 				Optional: true,
 				Computed: true,
@@ -37,6 +84,16 @@ func ApplicationResourceSchema(ctx context.Context) schema.Schema {
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"id_token_ttl": schema.Int64Attribute{
+				Optional:            true,
+				Computed:            true,
+				Description:         "The ID token TTL in seconds.",
+				MarkdownDescription: "The ID token TTL in seconds.",
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+				Default: int64default.StaticInt64(3600),
+			},
 			"is_admin": schema.BoolAttribute{
 				Computed: true,
 			},
@@ -44,6 +101,14 @@ func ApplicationResourceSchema(ctx context.Context) schema.Schema {
 				Optional: true,
 				Computed: true,
 			},
+			"logo_uri": schema.StringAttribute{
+				Optional:            true,
+				Description:         "The URI of the logo of the application.",
+				MarkdownDescription: "The URI of the logo of the application.",
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexp.MustCompile(`^https?://`), "must be an http or https URL"),
+				},
+			},
 			"name": schema.StringAttribute{
 				Required: true,
 				Validators: []validator.String{
@@ -58,6 +123,73 @@ func ApplicationResourceSchema(ctx context.Context) schema.Schema {
 					listplanmodifier.NullIsEmpty(),
 				},
 			},
+			"protected_app": schema.SingleNestedAttribute{
+				Attributes: map[string]schema.Attribute{
+					"custom_domains": schema.SetAttribute{
+						ElementType:         types.StringType,
+						Optional:            true,
+						Computed:            true,
+						Description:         "The custom domains serving the protected app. Each domain must be verified by adding the DNS records shown in the Logto console.",
+						MarkdownDescription: "The custom domains serving the protected app. Each domain must be verified by adding the DNS records shown in the Logto console.",
+						Default:             setdefault.StaticValue(types.SetValueMust(types.StringType, []attr.Value{})),
+					},
+					"host": schema.StringAttribute{
+						Computed:            true,
+						Description:         "The host the protected app is reachable at.",
+						MarkdownDescription: "The host the protected app is reachable at.",
+						PlanModifiers: []planmodifier.String{
+							stringplanmodifier.UseStateForUnknown(),
+						},
+					},
+					"origin": schema.StringAttribute{
+						Required:            true,
+						Description:         "The URL of the application protected by Logto, requests are forwarded to it once the user is authenticated.",
+						MarkdownDescription: "The URL of the application protected by Logto, requests are forwarded to it once the user is authenticated.",
+						Validators: []validator.String{
+							stringvalidator.RegexMatches(regexp.MustCompile(`^https?://`), "must be an http or https URL"),
+						},
+					},
+					"page_rules": schema.ListAttribute{
+						ElementType:         types.StringType,
+						Optional:            true,
+						Computed:            true,
+						Description:         "The regular expressions matching the paths that require authentication. The whole application is protected when empty.",
+						MarkdownDescription: "The regular expressions matching the paths that require authentication. The whole application is protected when empty.",
+						PlanModifiers: []planmodifier.List{
+							listplanmodifier.NullIsEmpty(),
+						},
+					},
+					"session_duration": schema.Int64Attribute{
+						Optional:            true,
+						Computed:            true,
+						Description:         "The duration of the sessions in seconds.",
+						MarkdownDescription: "The duration of the sessions in seconds.",
+						Validators: []validator.Int64{
+							int64validator.AtLeast(1),
+						},
+						Default: int64default.StaticInt64(1209600),
+					},
+					"sub_domain": schema.StringAttribute{
+						Required:            true,
+						Description:         "The subdomain the protected app is reachable at. Changing it forces a new application to be created.",
+						MarkdownDescription: "The subdomain the protected app is reachable at. Changing it forces a new application to be created.",
+						PlanModifiers: []planmodifier.String{
+							stringplanmodifier.RequiresReplace(),
+						},
+						Validators: []validator.String{
+							stringvalidator.RegexMatches(regexp.MustCompile(`^[a-z0-9]([a-z0-9-]*[a-z0-9])?$`), "must only contain lowercase letters, digits and hyphens"),
+						},
+					},
+				},
+				CustomType: ProtectedAppType{
+					ObjectType: types.ObjectType{
+						AttrTypes: ProtectedAppValue{}.AttributeTypes(ctx),
+					},
+				},
+				Optional:            true,
+				Description:         "The configuration of the protected app, required when `type` is `Protected` and not allowed otherwise.",
+				MarkdownDescription: "The configuration of the protected app, required when `type` is `Protected` and not allowed otherwise.",
+			},
 			"redirect_uris": schema.ListAttribute{
 				ElementType: types.StringType,
 				Optional:    true,
@@ -66,6 +198,29 @@ func ApplicationResourceSchema(ctx context.Context) schema.Schema {
 					listplanmodifier.NullIsEmpty(),
 				},
 			},
+			"refresh_token_ttl_in_days": schema.Int64Attribute{
+				Optional:            true,
+				Computed:            true,
+				Description:         "The refresh token TTL in days.",
+				MarkdownDescription: "The refresh token TTL in days.",
+				Validators: []validator.Int64{
+					int64validator.Between(1, 90),
+				},
+				Default: int64default.StaticInt64(14),
+			},
+			"role_ids": schema.SetAttribute{
+				ElementType:         types.StringType,
+				Optional:            true,
+				Description:         "The machine-to-machine roles assigned to the application. Only supported by `MachineToMachine` applications.",
+				MarkdownDescription: "The machine-to-machine roles assigned to the application. Only supported by `MachineToMachine` applications.",
+			},
+			"rotate_refresh_token": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Description:         "Whether a new refresh token is issued each time one is used, invalidating the previous one.",
+				MarkdownDescription: "Whether a new refresh token is issued each time one is used, invalidating the previous one.",
+				Default:             booldefault.StaticBool(true),
+			},
 			"tenant_id": schema.StringAttribute{
 				Computed: true,
 			},
@@ -90,14 +245,690 @@ func ApplicationResourceSchema(ctx context.Context) schema.Schema {
 }
 
 type ApplicationModel struct {
-	CorsAllowedOrigins     types.List   `tfsdk:"cors_allowed_origins"`
-	Description            types.String `tfsdk:"description"`
-	Id                     types.String `tfsdk:"id"`
-	IsAdmin                types.Bool   `tfsdk:"is_admin"`
-	IsThirdParty           types.Bool   `tfsdk:"is_third_party"`
-	Name                   types.String `tfsdk:"name"`
-	PostLogoutRedirectUris types.List   `tfsdk:"post_logout_redirect_uris"`
-	RedirectUris           types.List   `tfsdk:"redirect_uris"`
-	TenantId               types.String `tfsdk:"tenant_id"`
-	Type                   types.String `tfsdk:"type"`
+	AlwaysIssueRefreshToken          types.Bool           `tfsdk:"always_issue_refresh_token"`
+	BackchannelLogoutSessionRequired types.Bool           `tfsdk:"backchannel_logout_session_required"`
+	BackchannelLogoutUri             types.String         `tfsdk:"backchannel_logout_uri"`
+	CorsAllowedOrigins               types.List           `tfsdk:"cors_allowed_origins"`
+	CustomData                       jsontypes.Normalized `tfsdk:"custom_data"`
+	CustomDataKeys                   types.Set            `tfsdk:"custom_data_keys"`
+	Description                      types.String         `tfsdk:"description"`
+	Id                               types.String         `tfsdk:"id"`
+	IdTokenTtl                       types.Int64          `tfsdk:"id_token_ttl"`
+	IsAdmin                          types.Bool           `tfsdk:"is_admin"`
+	IsThirdParty                     types.Bool           `tfsdk:"is_third_party"`
+	LogoUri                          types.String         `tfsdk:"logo_uri"`
+	Name                             types.String         `tfsdk:"name"`
+	PostLogoutRedirectUris           types.List           `tfsdk:"post_logout_redirect_uris"`
+	ProtectedApp                     ProtectedAppValue    `tfsdk:"protected_app"`
+	RedirectUris                     types.List           `tfsdk:"redirect_uris"`
+	RefreshTokenTtlInDays            types.Int64          `tfsdk:"refresh_token_ttl_in_days"`
+	RoleIds                          types.Set            `tfsdk:"role_ids"`
+	RotateRefreshToken               types.Bool           `tfsdk:"rotate_refresh_token"`
+	TenantId                         types.String         `tfsdk:"tenant_id"`
+	Type                             types.String         `tfsdk:"type"`
+}
+
+var _ basetypes.ObjectTypable = ProtectedAppType{}
+
+type ProtectedAppType struct {
+	basetypes.ObjectType
+}
+
+func (t ProtectedAppType) Equal(o attr.Type) bool {
+	other, ok := o.(ProtectedAppType)
+
+	if !ok {
+		return false
+	}
+
+	return t.ObjectType.Equal(other.ObjectType)
+}
+
+func (t ProtectedAppType) String() string {
+	return "ProtectedAppType"
+}
+
+func (t ProtectedAppType) ValueFromObject(ctx context.Context, in basetypes.ObjectValue) (basetypes.ObjectValuable, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	attributes := in.Attributes()
+
+	customDomainsAttribute, ok := attributes["custom_domains"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`custom_domains is missing from object`)
+
+		return nil, diags
+	}
+
+	customDomainsVal, ok := customDomainsAttribute.(basetypes.SetValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`custom_domains expected to be basetypes.SetValue, was: %T`, customDomainsAttribute))
+	}
+
+	hostAttribute, ok := attributes["host"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`host is missing from object`)
+
+		return nil, diags
+	}
+
+	hostVal, ok := hostAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`host expected to be basetypes.StringValue, was: %T`, hostAttribute))
+	}
+
+	originAttribute, ok := attributes["origin"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`origin is missing from object`)
+
+		return nil, diags
+	}
+
+	originVal, ok := originAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`origin expected to be basetypes.StringValue, was: %T`, originAttribute))
+	}
+
+	pageRulesAttribute, ok := attributes["page_rules"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`page_rules is missing from object`)
+
+		return nil, diags
+	}
+
+	pageRulesVal, ok := pageRulesAttribute.(basetypes.ListValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`page_rules expected to be basetypes.ListValue, was: %T`, pageRulesAttribute))
+	}
+
+	sessionDurationAttribute, ok := attributes["session_duration"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`session_duration is missing from object`)
+
+		return nil, diags
+	}
+
+	sessionDurationVal, ok := sessionDurationAttribute.(basetypes.Int64Value)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`session_duration expected to be basetypes.Int64Value, was: %T`, sessionDurationAttribute))
+	}
+
+	subDomainAttribute, ok := attributes["sub_domain"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`sub_domain is missing from object`)
+
+		return nil, diags
+	}
+
+	subDomainVal, ok := subDomainAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`sub_domain expected to be basetypes.StringValue, was: %T`, subDomainAttribute))
+	}
+
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	return ProtectedAppValue{
+		CustomDomains:   customDomainsVal,
+		Host:            hostVal,
+		Origin:          originVal,
+		PageRules:       pageRulesVal,
+		SessionDuration: sessionDurationVal,
+		SubDomain:       subDomainVal,
+		state:           attr.ValueStateKnown,
+	}, diags
+}
+
+func NewProtectedAppValueNull() ProtectedAppValue {
+	return ProtectedAppValue{
+		state: attr.ValueStateNull,
+	}
+}
+
+func NewProtectedAppValueUnknown() ProtectedAppValue {
+	return ProtectedAppValue{
+		state: attr.ValueStateUnknown,
+	}
+}
+
+func NewProtectedAppValue(attributeTypes map[string]attr.Type, attributes map[string]attr.Value) (ProtectedAppValue, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	// Reference: https://github.com/hashicorp/terraform-plugin-framework/issues/521
+	ctx := context.Background()
+
+	for name, attributeType := range attributeTypes {
+		attribute, ok := attributes[name]
+
+		if !ok {
+			diags.AddError(
+				"Missing ProtectedAppValue Attribute Value",
+				"While creating a ProtectedAppValue value, a missing attribute value was detected. "+
+					"A ProtectedAppValue must contain values for all attributes, even if null or unknown. "+
+					"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+					fmt.Sprintf("ProtectedAppValue Attribute Name (%s) Expected Type: %s", name, attributeType.String()),
+			)
+
+			continue
+		}
+
+		if !attributeType.Equal(attribute.Type(ctx)) {
+			diags.AddError(
+				"Invalid ProtectedAppValue Attribute Type",
+				"While creating a ProtectedAppValue value, an invalid attribute value was detected. "+
+					"A ProtectedAppValue must use a matching attribute type for the value. "+
+					"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+					fmt.Sprintf("ProtectedAppValue Attribute Name (%s) Expected Type: %s\n", name, attributeType.String())+
+					fmt.Sprintf("ProtectedAppValue Attribute Name (%s) Given Type: %s", name, attribute.Type(ctx)),
+			)
+		}
+	}
+
+	for name := range attributes {
+		_, ok := attributeTypes[name]
+
+		if !ok {
+			diags.AddError(
+				"Extra ProtectedAppValue Attribute Value",
+				"While creating a ProtectedAppValue value, an extra attribute value was detected. "+
+					"A ProtectedAppValue must not contain values beyond the expected attribute types. "+
+					"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+					fmt.Sprintf("Extra ProtectedAppValue Attribute Name: %s", name),
+			)
+		}
+	}
+
+	if diags.HasError() {
+		return NewProtectedAppValueUnknown(), diags
+	}
+
+	customDomainsAttribute, ok := attributes["custom_domains"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`custom_domains is missing from object`)
+
+		return NewProtectedAppValueUnknown(), diags
+	}
+
+	customDomainsVal, ok := customDomainsAttribute.(basetypes.SetValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`custom_domains expected to be basetypes.SetValue, was: %T`, customDomainsAttribute))
+	}
+
+	hostAttribute, ok := attributes["host"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`host is missing from object`)
+
+		return NewProtectedAppValueUnknown(), diags
+	}
+
+	hostVal, ok := hostAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`host expected to be basetypes.StringValue, was: %T`, hostAttribute))
+	}
+
+	originAttribute, ok := attributes["origin"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`origin is missing from object`)
+
+		return NewProtectedAppValueUnknown(), diags
+	}
+
+	originVal, ok := originAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`origin expected to be basetypes.StringValue, was: %T`, originAttribute))
+	}
+
+	pageRulesAttribute, ok := attributes["page_rules"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`page_rules is missing from object`)
+
+		return NewProtectedAppValueUnknown(), diags
+	}
+
+	pageRulesVal, ok := pageRulesAttribute.(basetypes.ListValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`page_rules expected to be basetypes.ListValue, was: %T`, pageRulesAttribute))
+	}
+
+	sessionDurationAttribute, ok := attributes["session_duration"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`session_duration is missing from object`)
+
+		return NewProtectedAppValueUnknown(), diags
+	}
+
+	sessionDurationVal, ok := sessionDurationAttribute.(basetypes.Int64Value)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`session_duration expected to be basetypes.Int64Value, was: %T`, sessionDurationAttribute))
+	}
+
+	subDomainAttribute, ok := attributes["sub_domain"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`sub_domain is missing from object`)
+
+		return NewProtectedAppValueUnknown(), diags
+	}
+
+	subDomainVal, ok := subDomainAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`sub_domain expected to be basetypes.StringValue, was: %T`, subDomainAttribute))
+	}
+
+	if diags.HasError() {
+		return NewProtectedAppValueUnknown(), diags
+	}
+
+	return ProtectedAppValue{
+		CustomDomains:   customDomainsVal,
+		Host:            hostVal,
+		Origin:          originVal,
+		PageRules:       pageRulesVal,
+		SessionDuration: sessionDurationVal,
+		SubDomain:       subDomainVal,
+		state:           attr.ValueStateKnown,
+	}, diags
+}
+
+func NewProtectedAppValueMust(attributeTypes map[string]attr.Type, attributes map[string]attr.Value) ProtectedAppValue {
+	object, diags := NewProtectedAppValue(attributeTypes, attributes)
+
+	if diags.HasError() {
+		// This could potentially be added to the diag package.
+		diagsStrings := make([]string, 0, len(diags))
+
+		for _, diagnostic := range diags {
+			diagsStrings = append(diagsStrings, fmt.Sprintf(
+				"%s | %s | %s",
+				diagnostic.Severity(),
+				diagnostic.Summary(),
+				diagnostic.Detail()))
+		}
+
+		panic("NewProtectedAppValueMust received error(s): " + strings.Join(diagsStrings, "\n"))
+	}
+
+	return object
+}
+
+func (t ProtectedAppType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	if in.Type() == nil {
+		return NewProtectedAppValueNull(), nil
+	}
+
+	if !in.Type().Equal(t.TerraformType(ctx)) {
+		return nil, fmt.Errorf("expected %s, got %s", t.TerraformType(ctx), in.Type())
+	}
+
+	if !in.IsKnown() {
+		return NewProtectedAppValueUnknown(), nil
+	}
+
+	if in.IsNull() {
+		return NewProtectedAppValueNull(), nil
+	}
+
+	attributes := map[string]attr.Value{}
+
+	val := map[string]tftypes.Value{}
+
+	err := in.As(&val)
+
+	if err != nil {
+		return nil, err
+	}
+
+	for k, v := range val {
+		a, err := t.AttrTypes[k].ValueFromTerraform(ctx, v)
+
+		if err != nil {
+			return nil, err
+		}
+
+		attributes[k] = a
+	}
+
+	return NewProtectedAppValueMust(ProtectedAppValue{}.AttributeTypes(ctx), attributes), nil
+}
+
+func (t ProtectedAppType) ValueType(ctx context.Context) attr.Value {
+	return ProtectedAppValue{}
+}
+
+var _ basetypes.ObjectValuable = ProtectedAppValue{}
+
+type ProtectedAppValue struct {
+	CustomDomains   basetypes.SetValue    `tfsdk:"custom_domains"`
+	Host            basetypes.StringValue `tfsdk:"host"`
+	Origin          basetypes.StringValue `tfsdk:"origin"`
+	PageRules       basetypes.ListValue   `tfsdk:"page_rules"`
+	SessionDuration basetypes.Int64Value  `tfsdk:"session_duration"`
+	SubDomain       basetypes.StringValue `tfsdk:"sub_domain"`
+	state           attr.ValueState
+}
+
+func (v ProtectedAppValue) ToTerraformValue(ctx context.Context) (tftypes.Value, error) {
+	attrTypes := make(map[string]tftypes.Type, 6)
+
+	var val tftypes.Value
+	var err error
+
+	attrTypes["custom_domains"] = basetypes.SetType{
+		ElemType: types.StringType,
+	}.TerraformType(ctx)
+	attrTypes["host"] = basetypes.StringType{}.TerraformType(ctx)
+	attrTypes["origin"] = basetypes.StringType{}.TerraformType(ctx)
+	attrTypes["page_rules"] = basetypes.ListType{
+		ElemType: types.StringType,
+	}.TerraformType(ctx)
+	attrTypes["session_duration"] = basetypes.Int64Type{}.TerraformType(ctx)
+	attrTypes["sub_domain"] = basetypes.StringType{}.TerraformType(ctx)
+
+	objectType := tftypes.Object{AttributeTypes: attrTypes}
+
+	switch v.state {
+	case attr.ValueStateKnown:
+		vals := make(map[string]tftypes.Value, 6)
+
+		val, err = v.CustomDomains.ToTerraformValue(ctx)
+
+		if err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		vals["custom_domains"] = val
+
+		val, err = v.Host.ToTerraformValue(ctx)
+
+		if err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		vals["host"] = val
+
+		val, err = v.Origin.ToTerraformValue(ctx)
+
+		if err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		vals["origin"] = val
+
+		val, err = v.PageRules.ToTerraformValue(ctx)
+
+		if err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		vals["page_rules"] = val
+
+		val, err = v.SessionDuration.ToTerraformValue(ctx)
+
+		if err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		vals["session_duration"] = val
+
+		val, err = v.SubDomain.ToTerraformValue(ctx)
+
+		if err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		vals["sub_domain"] = val
+
+		if err := tftypes.ValidateValue(objectType, vals); err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		return tftypes.NewValue(objectType, vals), nil
+	case attr.ValueStateNull:
+		return tftypes.NewValue(objectType, nil), nil
+	case attr.ValueStateUnknown:
+		return tftypes.NewValue(objectType, tftypes.UnknownValue), nil
+	default:
+		panic(fmt.Sprintf("unhandled Object state in ToTerraformValue: %s", v.state))
+	}
+}
+
+func (v ProtectedAppValue) IsNull() bool {
+	return v.state == attr.ValueStateNull
+}
+
+func (v ProtectedAppValue) IsUnknown() bool {
+	return v.state == attr.ValueStateUnknown
+}
+
+func (v ProtectedAppValue) String() string {
+	return "ProtectedAppValue"
+}
+
+func (v ProtectedAppValue) ToObjectValue(ctx context.Context) (basetypes.ObjectValue, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	var customDomainsVal basetypes.SetValue
+	switch {
+	case v.CustomDomains.IsUnknown():
+		customDomainsVal = types.SetUnknown(types.StringType)
+	case v.CustomDomains.IsNull():
+		customDomainsVal = types.SetNull(types.StringType)
+	default:
+		var d diag.Diagnostics
+		customDomainsVal, d = types.SetValue(types.StringType, v.CustomDomains.Elements())
+		diags.Append(d...)
+	}
+
+	if diags.HasError() {
+		return types.ObjectUnknown(map[string]attr.Type{
+			"custom_domains": basetypes.SetType{
+				ElemType: types.StringType,
+			},
+			"host":   basetypes.StringType{},
+			"origin": basetypes.StringType{},
+			"page_rules": basetypes.ListType{
+				ElemType: types.StringType,
+			},
+			"session_duration": basetypes.Int64Type{},
+			"sub_domain":       basetypes.StringType{},
+		}), diags
+	}
+
+	var pageRulesVal basetypes.ListValue
+	switch {
+	case v.PageRules.IsUnknown():
+		pageRulesVal = types.ListUnknown(types.StringType)
+	case v.PageRules.IsNull():
+		pageRulesVal = types.ListNull(types.StringType)
+	default:
+		var d diag.Diagnostics
+		pageRulesVal, d = types.ListValue(types.StringType, v.PageRules.Elements())
+		diags.Append(d...)
+	}
+
+	if diags.HasError() {
+		return types.ObjectUnknown(map[string]attr.Type{
+			"custom_domains": basetypes.SetType{
+				ElemType: types.StringType,
+			},
+			"host":   basetypes.StringType{},
+			"origin": basetypes.StringType{},
+			"page_rules": basetypes.ListType{
+				ElemType: types.StringType,
+			},
+			"session_duration": basetypes.Int64Type{},
+			"sub_domain":       basetypes.StringType{},
+		}), diags
+	}
+
+	attributeTypes := map[string]attr.Type{
+		"custom_domains": basetypes.SetType{
+			ElemType: types.StringType,
+		},
+		"host":   basetypes.StringType{},
+		"origin": basetypes.StringType{},
+		"page_rules": basetypes.ListType{
+			ElemType: types.StringType,
+		},
+		"session_duration": basetypes.Int64Type{},
+		"sub_domain":       basetypes.StringType{},
+	}
+
+	if v.IsNull() {
+		return types.ObjectNull(attributeTypes), diags
+	}
+
+	if v.IsUnknown() {
+		return types.ObjectUnknown(attributeTypes), diags
+	}
+
+	objVal, diags := types.ObjectValue(
+		attributeTypes,
+		map[string]attr.Value{
+			"custom_domains":   customDomainsVal,
+			"host":             v.Host,
+			"origin":           v.Origin,
+			"page_rules":       pageRulesVal,
+			"session_duration": v.SessionDuration,
+			"sub_domain":       v.SubDomain,
+		})
+
+	return objVal, diags
+}
+
+func (v ProtectedAppValue) Equal(o attr.Value) bool {
+	other, ok := o.(ProtectedAppValue)
+
+	if !ok {
+		return false
+	}
+
+	if v.state != other.state {
+		return false
+	}
+
+	if v.state != attr.ValueStateKnown {
+		return true
+	}
+
+	if !v.CustomDomains.Equal(other.CustomDomains) {
+		return false
+	}
+
+	if !v.Host.Equal(other.Host) {
+		return false
+	}
+
+	if !v.Origin.Equal(other.Origin) {
+		return false
+	}
+
+	if !v.PageRules.Equal(other.PageRules) {
+		return false
+	}
+
+	if !v.SessionDuration.Equal(other.SessionDuration) {
+		return false
+	}
+
+	if !v.SubDomain.Equal(other.SubDomain) {
+		return false
+	}
+
+	return true
+}
+
+func (v ProtectedAppValue) Type(ctx context.Context) attr.Type {
+	return ProtectedAppType{
+		basetypes.ObjectType{
+			AttrTypes: v.AttributeTypes(ctx),
+		},
+	}
+}
+
+func (v ProtectedAppValue) AttributeTypes(ctx context.Context) map[string]attr.Type {
+	return map[string]attr.Type{
+		"custom_domains": basetypes.SetType{
+			ElemType: types.StringType,
+		},
+		"host":   basetypes.StringType{},
+		"origin": basetypes.StringType{},
+		"page_rules": basetypes.ListType{
+			ElemType: types.StringType,
+		},
+		"session_duration": basetypes.Int64Type{},
+		"sub_domain":       basetypes.StringType{},
+	}
 }
@@ -0,0 +1,191 @@
+package resource_application_secret
+
+import (
+	"context"
+	"math/big"
+	"strings"
+
+	"github.com/Lenstra/terraform-provider-logto/client"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.ResourceWithImportState = &applicationSecretResource{}
+	_ resource.ResourceWithModifyPlan  = &applicationSecretResource{}
+)
+
+func (r *applicationSecretResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan ApplicationSecretModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	secret, err := r.client.ApplicationSecretCreate(ctx, plan.ApplicationId.ValueString(), decodePlan(plan))
+	if err != nil {
+		resp.Diagnostics.AddError("Error creating application secret", err.Error())
+		return
+	}
+
+	diags = resp.State.Set(ctx, convertToTerraformModel(plan.ApplicationId.ValueString(), secret, plan.Keepers))
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *applicationSecretResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state ApplicationSecretModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	applicationId := state.ApplicationId.ValueString()
+	secret, err := r.findSecret(ctx, applicationId, state.Name.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading application secrets", err.Error())
+		return
+	}
+
+	if secret == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	diags = resp.State.Set(ctx, convertToTerraformModel(applicationId, secret, state.Keepers))
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *applicationSecretResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state ApplicationSecretModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Every other attribute requires a replacement so only the name can
+	// change here.
+	secret, err := r.client.ApplicationSecretUpdate(
+		ctx,
+		state.ApplicationId.ValueString(),
+		state.Name.ValueString(),
+		&client.Secret{Name: plan.Name.ValueString()},
+	)
+	if err != nil {
+		resp.Diagnostics.AddError("Error renaming application secret", err.Error())
+		return
+	}
+
+	// The value is not always returned when updating the secret, keep the
+	// one we already know about.
+	if secret.Value == "" {
+		secret.Value = state.Value.ValueString()
+	}
+
+	diags := resp.State.Set(ctx, convertToTerraformModel(plan.ApplicationId.ValueString(), secret, plan.Keepers))
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *applicationSecretResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state ApplicationSecretModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.ApplicationSecretDelete(ctx, state.ApplicationId.ValueString(), state.Name.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error deleting application secret", err.Error())
+	}
+}
+
+// ModifyPlan plans the new identifier of the secret when it is renamed, as
+// the identifier holds its name.
+func (r *applicationSecretResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan, state ApplicationSecretModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() || plan.Name.Equal(state.Name) {
+		return
+	}
+
+	id := types.StringUnknown()
+	if !plan.ApplicationId.IsUnknown() && !plan.Name.IsUnknown() {
+		id = types.StringValue(plan.ApplicationId.ValueString() + "/" + plan.Name.ValueString())
+	}
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("id"), id)...)
+}
+
+func (r *applicationSecretResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Secret names may contain slashes but application IDs never do.
+	parts := strings.SplitN(req.ID, "/", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected import identifier",
+			"Expected format: <application_id>/<name>",
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("application_id"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), parts[1])...)
+}
+
+// findSecret returns the secret called name, or nil if either the application
+// or the secret does not exist anymore.
+func (r *applicationSecretResource) findSecret(ctx context.Context, applicationId, name string) (*client.Secret, error) {
+	secrets, err := r.client.GetApplicationSecrets(ctx, applicationId)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, secret := range secrets {
+		if secret.Name == name {
+			return &secret, nil
+		}
+	}
+	return nil, nil
+}
+
+func decodePlan(plan ApplicationSecretModel) *client.Secret {
+	secret := &client.Secret{
+		Name: plan.Name.ValueString(),
+	}
+
+	if !plan.ExpiresAt.IsNull() && !plan.ExpiresAt.IsUnknown() {
+		expiresAt, _ := plan.ExpiresAt.ValueBigFloat().Float64()
+		secret.ExpiresAt = &expiresAt
+	}
+
+	return secret
+}
+
+func convertToTerraformModel(applicationId string, secret *client.Secret, keepers types.Map) ApplicationSecretModel {
+	model := ApplicationSecretModel{
+		Id:            types.StringValue(applicationId + "/" + secret.Name),
+		ApplicationId: types.StringValue(applicationId),
+		Name:          types.StringValue(secret.Name),
+		Value:         types.StringValue(secret.Value),
+		CreatedAt:     types.NumberNull(),
+		ExpiresAt:     types.NumberNull(),
+		Keepers:       keepers,
+	}
+
+	if secret.CreatedAt != nil {
+		model.CreatedAt = types.NumberValue(big.NewFloat(*secret.CreatedAt))
+	}
+	if secret.ExpiresAt != nil {
+		model.ExpiresAt = types.NumberValue(big.NewFloat(*secret.ExpiresAt))
+	}
+
+	return model
+}
@@ -0,0 +1,209 @@
+package resource_organization
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/Lenstra/terraform-provider-logto/client"
+	"github.com/Lenstra/terraform-provider-logto/internal/provider/customdata"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.ResourceWithValidateConfig = &organizationResource{}
+)
+
+func (r *organizationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan, state OrganizationModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	organization, diags := decodePlan(ctx, plan, nil)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	organization, err := r.client.OrganizationCreate(ctx, organization)
+	if err != nil {
+		resp.Diagnostics.AddError("Error creating organization", err.Error())
+		return
+	}
+
+	diags = convertToTerraformModel(ctx, organization, plan.CustomDataKeys, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *organizationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state OrganizationModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	organization, err := r.client.OrganizationGet(ctx, state.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading organization", err.Error())
+		return
+	}
+
+	if organization == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	diags = convertToTerraformModel(ctx, organization, state.CustomDataKeys, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *organizationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state OrganizationModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var current json.RawMessage
+	if !plan.CustomDataKeys.IsNull() {
+		organization, err := r.client.OrganizationGet(ctx, plan.Id.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("Error reading organization", err.Error())
+			return
+		}
+		if organization != nil {
+			current = organization.CustomData
+		}
+	}
+
+	organization, diags := decodePlan(ctx, plan, current)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	organization, err := r.client.OrganizationUpdate(ctx, organization)
+	if err != nil {
+		resp.Diagnostics.AddError("Error updating organization", err.Error())
+		return
+	}
+
+	diags = convertToTerraformModel(ctx, organization, plan.CustomDataKeys, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *organizationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state OrganizationModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.OrganizationDelete(ctx, state.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error deleting organization", err.Error())
+	}
+}
+
+// decodePlan returns the organization to send to Logto, current holds its
+// custom data when only some of its keys are managed by Terraform.
+func decodePlan(ctx context.Context, plan OrganizationModel, current json.RawMessage) (*client.OrganizationModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	model := &client.OrganizationModel{
+		ID:          plan.Id.ValueString(),
+		Name:        plan.Name.ValueString(),
+		Description: plan.Description.ValueString(),
+	}
+
+	if !plan.IsMfaRequired.IsNull() && !plan.IsMfaRequired.IsUnknown() {
+		model.IsMfaRequired = plan.IsMfaRequired.ValueBool()
+	}
+
+	var d diag.Diagnostics
+	model.CustomData, d = customdata.Expand(ctx, plan.CustomData, plan.CustomDataKeys, current)
+	diags.Append(d...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	if !plan.Branding.IsNull() && !plan.Branding.IsUnknown() {
+		model.Branding = &client.OrganizationBranding{
+			LogoUrl:     plan.Branding.LogoUrl.ValueString(),
+			DarkLogoUrl: plan.Branding.DarkLogoUrl.ValueString(),
+			Favicon:     plan.Branding.Favicon.ValueString(),
+			DarkFavicon: plan.Branding.DarkFavicon.ValueString(),
+		}
+	}
+
+	return model, diags
+}
+
+func convertToTerraformModel(ctx context.Context, organization *client.OrganizationModel, customDataKeys types.Set, model *OrganizationModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	*model = OrganizationModel{
+		Id:             types.StringValue(organization.ID),
+		TenantId:       types.StringValue(organization.TenantId),
+		Name:           types.StringValue(organization.Name),
+		Description:    types.StringValue(organization.Description),
+		IsMfaRequired:  types.BoolValue(organization.IsMfaRequired),
+		CustomDataKeys: customDataKeys,
+	}
+
+	model.CustomData, diags = customdata.Flatten(ctx, organization.CustomData, customDataKeys)
+	if diags.HasError() {
+		return diags
+	}
+
+	branding := organization.Branding
+	if branding == nil {
+		branding = &client.OrganizationBranding{}
+	}
+	model.Branding = BrandingValue{
+		LogoUrl:     types.StringValue(branding.LogoUrl),
+		DarkLogoUrl: types.StringValue(branding.DarkLogoUrl),
+		Favicon:     types.StringValue(branding.Favicon),
+		DarkFavicon: types.StringValue(branding.DarkFavicon),
+		state:       attr.ValueStateKnown,
+	}
+
+	return diags
+}
+
+func (r *organizationResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config OrganizationModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(customdata.Validate(ctx, config.CustomData, config.CustomDataKeys)...)
+}
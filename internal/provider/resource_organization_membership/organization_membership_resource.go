@@ -0,0 +1,171 @@
+package resource_organization_membership
+
+import (
+	"context"
+	"strings"
+
+	"github.com/Lenstra/terraform-provider-logto/client"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.ResourceWithImportState = &organizationMembershipResource{}
+)
+
+func (r *organizationMembershipResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan OrganizationMembershipModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	organizationId, userId := plan.OrganizationId.ValueString(), plan.UserId.ValueString()
+	roleIds, diags := decodePlan(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.OrganizationUsersAdd(ctx, organizationId, &client.UserIdsModel{UserIds: []string{userId}})
+	if err != nil {
+		resp.Diagnostics.AddError("Error adding user to organization", err.Error())
+		return
+	}
+
+	// Put the membership into the state before assigning roles in case of error during roles assignment
+	diags = resp.State.Set(ctx, convertToTerraformModel(organizationId, userId, nil))
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if len(roleIds.OrganizationRoleIds) != 0 {
+		err = r.client.OrganizationUserRolesUpdate(ctx, organizationId, userId, roleIds)
+		if err != nil {
+			resp.Diagnostics.AddError("Error assigning organization role(s) to user", err.Error())
+			return
+		}
+	}
+
+	diags = resp.State.Set(ctx, convertToTerraformModel(organizationId, userId, roleIds.OrganizationRoleIds))
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *organizationMembershipResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state OrganizationMembershipModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	organizationId, userId := state.OrganizationId.ValueString(), state.UserId.ValueString()
+
+	// Listing the organizations of the user tells both whether the user is
+	// still a member and which roles they have, in a single call.
+	organizations, err := r.client.UserOrganizationsGet(ctx, userId)
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading organizations of user", err.Error())
+		return
+	}
+
+	var membership *client.UserOrganizationModel
+	for _, organization := range organizations {
+		if organization.ID == organizationId {
+			membership = &organization
+			break
+		}
+	}
+
+	if membership == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	roleIds := make([]string, 0, len(membership.OrganizationRoles))
+	for _, role := range membership.OrganizationRoles {
+		roleIds = append(roleIds, role.ID)
+	}
+
+	diags = resp.State.Set(ctx, convertToTerraformModel(organizationId, userId, roleIds))
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *organizationMembershipResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan OrganizationMembershipModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	organizationId, userId := plan.OrganizationId.ValueString(), plan.UserId.ValueString()
+	roleIds, diags := decodePlan(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.OrganizationUserRolesUpdate(ctx, organizationId, userId, roleIds)
+	if err != nil {
+		resp.Diagnostics.AddError("Error updating organization role(s) of user", err.Error())
+		return
+	}
+
+	diags = resp.State.Set(ctx, convertToTerraformModel(organizationId, userId, roleIds.OrganizationRoleIds))
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *organizationMembershipResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state OrganizationMembershipModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.OrganizationUserRemove(ctx, state.OrganizationId.ValueString(), state.UserId.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error removing user from organization", err.Error())
+	}
+}
+
+func (r *organizationMembershipResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts := strings.Split(req.ID, "/")
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected import identifier",
+			"Expected format: <organization_id>/<user_id>",
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("organization_id"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("user_id"), parts[1])...)
+}
+
+func decodePlan(ctx context.Context, plan OrganizationMembershipModel) (*client.OrganizationRoleIdsModel, diag.Diagnostics) {
+	roleIds := &client.OrganizationRoleIdsModel{OrganizationRoleIds: []string{}}
+	diags := plan.OrganizationRoleIds.ElementsAs(ctx, &roleIds.OrganizationRoleIds, false)
+	return roleIds, diags
+}
+
+func convertToTerraformModel(organizationId, userId string, roleIds []string) OrganizationMembershipModel {
+	roles := make([]attr.Value, 0, len(roleIds))
+	for _, roleId := range roleIds {
+		roles = append(roles, types.StringValue(roleId))
+	}
+
+	return OrganizationMembershipModel{
+		Id:                  types.StringValue(organizationId + "/" + userId),
+		OrganizationId:      types.StringValue(organizationId),
+		UserId:              types.StringValue(userId),
+		OrganizationRoleIds: types.SetValueMust(types.StringType, roles),
+	}
+}
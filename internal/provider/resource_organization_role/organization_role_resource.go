@@ -0,0 +1,235 @@
+package resource_organization_role
+
+import (
+	"context"
+
+	"github.com/Lenstra/terraform-provider-logto/client"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func (r *organizationRoleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan, state OrganizationRoleModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	role, diags := decodePlan(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	role, err := r.client.OrganizationRoleCreate(ctx, role)
+	if err != nil {
+		resp.Diagnostics.AddError("Error creating organization role", err.Error())
+		return
+	}
+
+	err = r.readScopes(ctx, role, !plan.OrganizationScopeIds.IsNull(), !plan.ResourceScopeIds.IsNull())
+	if err != nil {
+		resp.Diagnostics.AddError("Error fetching organization role scopes just after creation", err.Error())
+		return
+	}
+
+	diags = convertToTerraformModel(ctx, role, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *organizationRoleResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state OrganizationRoleModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	role, err := r.client.OrganizationRoleGet(ctx, state.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading organization role", err.Error())
+		return
+	}
+
+	if role == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	err = r.readScopes(ctx, role, !state.OrganizationScopeIds.IsNull(), !state.ResourceScopeIds.IsNull())
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading organization role scopes", err.Error())
+		return
+	}
+
+	diags = convertToTerraformModel(ctx, role, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *organizationRoleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state OrganizationRoleModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	role, diags := decodePlan(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The scopes cannot be patched, they are replaced using the dedicated
+	// endpoints once the role itself has been updated.
+	organizationScopeIds, resourceScopeIds := role.OrganizationScopeIds, role.ResourceScopeIds
+	role.OrganizationScopeIds, role.ResourceScopeIds = nil, nil
+
+	role, err := r.client.OrganizationRoleUpdate(ctx, role)
+	if err != nil {
+		resp.Diagnostics.AddError("Error updating organization role", err.Error())
+		return
+	}
+
+	if organizationScopeIds != nil {
+		err = r.client.OrganizationRoleScopesUpdate(ctx, role.ID, &client.OrganizationScopeIdsModel{OrganizationScopeIds: organizationScopeIds})
+		if err != nil {
+			resp.Diagnostics.AddError("Error updating organization scopes of organization role", err.Error())
+			return
+		}
+	}
+
+	if resourceScopeIds != nil {
+		err = r.client.OrganizationRoleResourceScopesUpdate(ctx, role.ID, &client.ScopeIdsModel{ScopeIds: resourceScopeIds})
+		if err != nil {
+			resp.Diagnostics.AddError("Error updating API resource scopes of organization role", err.Error())
+			return
+		}
+	}
+
+	err = r.readScopes(ctx, role, organizationScopeIds != nil, resourceScopeIds != nil)
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading organization role scopes", err.Error())
+		return
+	}
+
+	diags = convertToTerraformModel(ctx, role, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *organizationRoleResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state OrganizationRoleModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.OrganizationRoleDelete(ctx, state.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error deleting organization role", err.Error())
+	}
+}
+
+// readScopes fetches the scopes granted by the role. Only the sets managed
+// by the configuration are read so that scopes assigned outside of Terraform
+// do not produce a diff when the attribute is omitted.
+func (r *organizationRoleResource) readScopes(ctx context.Context, role *client.OrganizationRoleModel, organizationScopes, resourceScopes bool) error {
+	role.OrganizationScopeIds = nil
+	if organizationScopes {
+		scopes, err := r.client.OrganizationRoleScopesGet(ctx, role.ID)
+		if err != nil {
+			return err
+		}
+		role.OrganizationScopeIds = make([]string, 0, len(scopes))
+		for _, scope := range scopes {
+			role.OrganizationScopeIds = append(role.OrganizationScopeIds, scope.ID)
+		}
+	}
+
+	role.ResourceScopeIds = nil
+	if resourceScopes {
+		scopes, err := r.client.OrganizationRoleResourceScopesGet(ctx, role.ID)
+		if err != nil {
+			return err
+		}
+		role.ResourceScopeIds = make([]string, 0, len(scopes))
+		for _, scope := range scopes {
+			role.ResourceScopeIds = append(role.ResourceScopeIds, scope.ID)
+		}
+	}
+
+	return nil
+}
+
+func decodePlan(ctx context.Context, plan OrganizationRoleModel) (*client.OrganizationRoleModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	model := &client.OrganizationRoleModel{
+		ID:          plan.Id.ValueString(),
+		Name:        plan.Name.ValueString(),
+		Description: plan.Description.ValueString(),
+	}
+
+	if !plan.Type.IsNull() && !plan.Type.IsUnknown() {
+		model.Type = plan.Type.ValueString()
+	}
+
+	if !plan.OrganizationScopeIds.IsNull() && !plan.OrganizationScopeIds.IsUnknown() {
+		model.OrganizationScopeIds = []string{}
+		diags.Append(plan.OrganizationScopeIds.ElementsAs(ctx, &model.OrganizationScopeIds, false)...)
+	}
+
+	if !plan.ResourceScopeIds.IsNull() && !plan.ResourceScopeIds.IsUnknown() {
+		model.ResourceScopeIds = []string{}
+		diags.Append(plan.ResourceScopeIds.ElementsAs(ctx, &model.ResourceScopeIds, false)...)
+	}
+
+	return model, diags
+}
+
+func convertToTerraformModel(ctx context.Context, role *client.OrganizationRoleModel, model *OrganizationRoleModel) (diags diag.Diagnostics) {
+	*model = OrganizationRoleModel{
+		Id:                   types.StringValue(role.ID),
+		Name:                 types.StringValue(role.Name),
+		Description:          types.StringValue(role.Description),
+		Type:                 types.StringValue(role.Type),
+		OrganizationScopeIds: types.SetNull(types.StringType),
+		ResourceScopeIds:     types.SetNull(types.StringType),
+	}
+
+	if role.OrganizationScopeIds != nil {
+		model.OrganizationScopeIds, diags = types.SetValueFrom(ctx, types.StringType, role.OrganizationScopeIds)
+		if diags.HasError() {
+			return
+		}
+	}
+
+	if role.ResourceScopeIds != nil {
+		var d diag.Diagnostics
+		model.ResourceScopeIds, d = types.SetValueFrom(ctx, types.StringType, role.ResourceScopeIds)
+		diags.Append(d...)
+	}
+
+	return
+}
@@ -0,0 +1,101 @@
+package resource_organization_scope
+
+import (
+	"context"
+
+	"github.com/Lenstra/terraform-provider-logto/client"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func (r *organizationScopeResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan, state OrganizationScopeModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	scope, err := r.client.OrganizationScopeCreate(ctx, decodePlan(plan))
+	if err != nil {
+		resp.Diagnostics.AddError("Error creating organization scope", err.Error())
+		return
+	}
+
+	convertToTerraformModel(scope, &state)
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *organizationScopeResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state OrganizationScopeModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	scope, err := r.client.OrganizationScopeGet(ctx, state.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading organization scope", err.Error())
+		return
+	}
+
+	if scope == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	convertToTerraformModel(scope, &state)
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *organizationScopeResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state OrganizationScopeModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	scope, err := r.client.OrganizationScopeUpdate(ctx, decodePlan(plan))
+	if err != nil {
+		resp.Diagnostics.AddError("Error updating organization scope", err.Error())
+		return
+	}
+
+	convertToTerraformModel(scope, &state)
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *organizationScopeResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state OrganizationScopeModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.OrganizationScopeDelete(ctx, state.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error deleting organization scope", err.Error())
+	}
+}
+
+func decodePlan(plan OrganizationScopeModel) *client.OrganizationScopeModel {
+	return &client.OrganizationScopeModel{
+		ID:          plan.Id.ValueString(),
+		Name:        plan.Name.ValueString(),
+		Description: plan.Description.ValueString(),
+	}
+}
+
+func convertToTerraformModel(scope *client.OrganizationScopeModel, model *OrganizationScopeModel) {
+	*model = OrganizationScopeModel{
+		Id:          types.StringValue(scope.ID),
+		Name:        types.StringValue(scope.Name),
+		Description: types.StringValue(scope.Description),
+	}
+}
@@ -0,0 +1,116 @@
+package resource_role
+
+import (
+	"context"
+
+	"github.com/Lenstra/terraform-provider-logto/client"
+	"github.com/Lenstra/terraform-provider-logto/internal/provider/identity"
+	"github.com/Lenstra/terraform-provider-logto/internal/provider/listing"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ list.ListResourceWithConfigure = &roleListResource{}
+)
+
+type roleListResource struct {
+	client *client.Client
+}
+
+func RoleListResource() list.ListResource {
+	return &roleListResource{}
+}
+
+type RoleListModel struct {
+	Search types.String `tfsdk:"search"`
+	Type   types.String `tfsdk:"type"`
+}
+
+func (r *roleListResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_role"
+}
+
+func (r *roleListResource) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description:         "Lists the roles of the tenant.",
+		MarkdownDescription: "Lists the roles of the tenant.",
+		Attributes: map[string]schema.Attribute{
+			"search": schema.StringAttribute{
+				Optional:            true,
+				Description:         "Only list the roles whose identifier, name or description matches this value, where % matches any sequence of characters and _ any single character.",
+				MarkdownDescription: "Only list the roles whose identifier, name or description matches this value, where `%` matches any sequence of characters and `_` any single character.",
+			},
+			"type": schema.StringAttribute{
+				Optional:            true,
+				Description:         "Only list the roles of this type.",
+				MarkdownDescription: "Only list the roles of this type.",
+				Validators: []validator.String{
+					stringvalidator.OneOf(
+						"User",
+						"MachineToMachine",
+					),
+				},
+			},
+		},
+	}
+}
+
+func (r *roleListResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		return
+	}
+	r.client = client
+}
+
+func (r *roleListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var config RoleListModel
+	diags := req.Config.Get(ctx, &config)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	params := map[string]string{}
+	if !config.Search.IsNull() {
+		params["search"] = config.Search.ValueString()
+	}
+	if !config.Type.IsNull() {
+		params["type"] = config.Type.ValueString()
+	}
+
+	roles := &roleResource{client: r.client}
+	stream.Results = listing.Results(ctx, req, r.client.RolesAll(ctx, params), "Error listing roles", func(role client.RoleModel, result *list.ListResult) {
+		result.DisplayName = role.Name
+		result.Diagnostics.Append(identity.Set(ctx, result.Identity, role.ID)...)
+		if !req.IncludeResource {
+			return
+		}
+
+		// As for imported roles, the scopes are managed by the resource when
+		// the role has some.
+		scopeIds, err := roles.scopeIds(ctx, role.ID, types.ListNull(types.StringType))
+		if err != nil {
+			result.Diagnostics.AddError("Error reading role scopes", err.Error())
+			return
+		}
+		if len(scopeIds) != 0 {
+			role.ScopeIds = scopeIds
+		}
+
+		var model RoleModel
+		result.Diagnostics.Append(convertToTerraformModel(ctx, &role, &model)...)
+		if result.Diagnostics.HasError() {
+			return
+		}
+		result.Diagnostics.Append(result.Resource.Set(ctx, model)...)
+	})
+}
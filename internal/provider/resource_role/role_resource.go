@@ -2,15 +2,24 @@ package resource_role
 
 import (
 	"context"
+	"fmt"
+	"slices"
 
 	"github.com/Lenstra/terraform-provider-logto/client"
+	"github.com/Lenstra/terraform-provider-logto/internal/provider/identity"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 )
 
+var (
+	_ resource.ResourceWithImportState = &roleResource{}
+	_ resource.ResourceWithIdentity    = &roleResource{}
+)
+
 func (r *roleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var plan, state RoleModel
 	diags := req.Plan.Get(ctx, &plan)
@@ -26,15 +35,16 @@ func (r *roleResource) Create(ctx context.Context, req resource.CreateRequest, r
 		resp.Diagnostics.AddError("Error creating role", err.Error())
 		return
 	}
+	resp.Diagnostics.Append(identity.Set(ctx, resp.Identity, role.ID)...)
 
-	roleScopes, err := r.client.RoleScopesGet(ctx, role.ID)
-	if err != nil {
-		resp.Diagnostics.AddError("Error fetching roleScopes just after role creation", err.Error())
-		return
-	}
-
-	for _, scope := range roleScopes {
-		role.ScopeIds = append(role.ScopeIds, scope.ID)
+	// The scopes are only managed when scope_ids is set.
+	role.ScopeIds = nil
+	if !plan.ScopeIds.IsNull() {
+		role.ScopeIds, err = r.scopeIds(ctx, role.ID, plan.ScopeIds)
+		if err != nil {
+			resp.Diagnostics.AddError("Error reading role scopes", err.Error())
+			return
+		}
 	}
 
 	diags = convertToTerraformModel(ctx, role, &state)
@@ -54,6 +64,7 @@ func (r *roleResource) Read(ctx context.Context, req resource.ReadRequest, resp
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	resp.Diagnostics.Append(identity.Set(ctx, resp.Identity, state.Id.ValueString())...)
 
 	role, err := r.client.RoleGet(ctx, state.Id.ValueString())
 	if err != nil {
@@ -62,22 +73,18 @@ func (r *roleResource) Read(ctx context.Context, req resource.ReadRequest, resp
 	}
 	if role == nil {
 		resp.State.RemoveResource(ctx)
-	}
-
-	roleScopes, err := r.client.RoleScopesGet(ctx, state.Id.ValueString())
-	if err != nil {
-		resp.Diagnostics.AddError("Error reading role scopes", err.Error())
 		return
 	}
 
-	if len(roleScopes) == 0 {
-		role.ScopeIds = nil
-	} else {
-		scopeIds := make([]string, 0, len(roleScopes))
-		for _, roleScope := range roleScopes {
-			scopeIds = append(scopeIds, roleScope.ID)
+	// The scopes are only refreshed when scope_ids is set, so that they can
+	// be managed by logto_role_scopes instead.
+	role.ScopeIds = nil
+	if !state.ScopeIds.IsNull() {
+		role.ScopeIds, err = r.scopeIds(ctx, role.ID, state.ScopeIds)
+		if err != nil {
+			resp.Diagnostics.AddError("Error reading role scopes", err.Error())
+			return
 		}
-		role.ScopeIds = scopeIds
 	}
 
 	diags = convertToTerraformModel(ctx, role, &state)
@@ -99,12 +106,29 @@ func (r *roleResource) Update(ctx context.Context, req resource.UpdateRequest, r
 	}
 
 	role := decodePlan(ctx, plan)
+	scopeIds := role.ScopeIds
 
+	// Logto ignores the scopes sent when patching a role, they are updated
+	// through the dedicated endpoints below.
+	role.ScopeIds = nil
 	role, err := r.client.RoleUpdate(ctx, role)
 	if err != nil {
 		resp.Diagnostics.AddError("Error updating role", err.Error())
 		return
 	}
+	resp.Diagnostics.Append(identity.Set(ctx, resp.Identity, role.ID)...)
+
+	if !plan.ScopeIds.IsNull() {
+		if scopeIds == nil {
+			scopeIds = []string{}
+		}
+		diags = r.updateScopes(ctx, role.ID, scopeIds)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		role.ScopeIds = scopeIds
+	}
 
 	diags = convertToTerraformModel(ctx, role, &state)
 	resp.Diagnostics.Append(diags...)
@@ -130,6 +154,131 @@ func (r *roleResource) Delete(ctx context.Context, req resource.DeleteRequest, r
 	}
 }
 
+func (r *roleResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identity.Schema()
+}
+
+// ImportState accepts either the identifier or the name of the role.
+func (r *roleResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	id, diags := identity.ImportID(ctx, req)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	role, err := r.client.RoleGet(ctx, id)
+	if err == nil && role == nil {
+		role, err = r.findByName(ctx, id)
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading role", err.Error())
+		return
+	}
+	if role == nil {
+		resp.Diagnostics.AddError("Cannot import role", fmt.Sprintf("No role has the identifier or the name %q.", id))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), role.ID)...)
+	resp.Diagnostics.Append(identity.Set(ctx, resp.Identity, role.ID)...)
+
+	// The scopes of the imported role are only managed when it has some, so
+	// that roles without scopes can be imported along with logto_role_scopes
+	// or with a configuration that does not set scope_ids.
+	scopeIds, err := r.scopeIds(ctx, role.ID, types.ListNull(types.StringType))
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading role scopes", err.Error())
+		return
+	}
+	if len(scopeIds) != 0 {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("scope_ids"), scopeIds)...)
+	}
+}
+
+// findByName returns the role called name, or nil if there is none.
+func (r *roleResource) findByName(ctx context.Context, name string) (*client.RoleModel, error) {
+	for role, err := range r.client.RolesAll(ctx, map[string]string{"search": name}) {
+		if err != nil {
+			return nil, err
+		}
+		if role.Name == name {
+			return &role, nil
+		}
+	}
+	return nil, nil
+}
+
+// scopeIds returns the identifiers of the scopes of the role. The scopes
+// found in prior come first and keep their order so that the list does not
+// change when Logto returns them in another order.
+func (r *roleResource) scopeIds(ctx context.Context, roleId string, prior types.List) ([]string, error) {
+	current := []string{}
+	for scope, err := range r.client.RoleScopesAll(ctx, roleId, nil) {
+		if err != nil {
+			return nil, err
+		}
+		current = append(current, scope.ID)
+	}
+
+	var ordered []string
+	diags := prior.ElementsAs(ctx, &ordered, true)
+	if diags.HasError() {
+		ordered = nil
+	}
+
+	res := make([]string, 0, len(current))
+	for _, id := range ordered {
+		if slices.Contains(current, id) && !slices.Contains(res, id) {
+			res = append(res, id)
+		}
+	}
+	for _, id := range current {
+		if !slices.Contains(res, id) {
+			res = append(res, id)
+		}
+	}
+	return res, nil
+}
+
+// updateScopes assigns and removes the scopes of the role so that it has
+// exactly scopeIds.
+func (r *roleResource) updateScopes(ctx context.Context, roleId string, scopeIds []string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	current := []string{}
+	for scope, err := range r.client.RoleScopesAll(ctx, roleId, nil) {
+		if err != nil {
+			diags.AddError("Error reading role scopes", err.Error())
+			return diags
+		}
+		current = append(current, scope.ID)
+	}
+
+	var toAdd []string
+	for _, id := range scopeIds {
+		if !slices.Contains(current, id) && !slices.Contains(toAdd, id) {
+			toAdd = append(toAdd, id)
+		}
+	}
+	if len(toAdd) != 0 {
+		if err := r.client.RoleScopesAdd(ctx, roleId, &client.ScopeIdsModel{ScopeIds: toAdd}); err != nil {
+			diags.AddError("Error adding scope(s) to role", err.Error())
+			return diags
+		}
+	}
+
+	for _, id := range current {
+		if slices.Contains(scopeIds, id) {
+			continue
+		}
+		if err := r.client.RoleScopeRemove(ctx, roleId, id); err != nil {
+			diags.AddError("Error removing scope from role", err.Error())
+			return diags
+		}
+	}
+	return diags
+}
+
 func decodePlan(ctx context.Context, plan RoleModel) *client.RoleModel {
 	model := &client.RoleModel{
 		ID:          plan.Id.ValueString(),
@@ -5,7 +5,6 @@ package resource_role
 import (
 	"context"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
-	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
@@ -46,11 +45,8 @@ func RoleResourceSchema(ctx context.Context) schema.Schema {
 			"scope_ids": schema.ListAttribute{
 				ElementType:         types.StringType,
 				Optional:            true,
-				Description:         "The initial API resource scopes assigned to the role.",
-				MarkdownDescription: "The initial API resource scopes assigned to the role.",
-				PlanModifiers: []planmodifier.List{
-					listplanmodifier.RequiresReplace(),
-				},
+				Description:         "The API resource scopes granted by the role. When not set, the scopes of the role are not managed. It must not be used along with `logto_role_scopes`.",
+				MarkdownDescription: "The API resource scopes granted by the role. When not set, the scopes of the role are not managed. It must not be used along with `logto_role_scopes`.",
 			},
 			"type": schema.StringAttribute{
 				Optional:            true,
@@ -58,6 +54,7 @@ func RoleResourceSchema(ctx context.Context) schema.Schema {
 				Description:         "The type of the role. It cannot be changed after creation.",
 				MarkdownDescription: "The type of the role. It cannot be changed after creation.",
 				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
 					stringplanmodifier.RequiresReplace(),
 				},
 				Validators: []validator.String{
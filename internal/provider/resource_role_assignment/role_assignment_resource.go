@@ -0,0 +1,326 @@
+package resource_role_assignment
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/Lenstra/terraform-provider-logto/client"
+	"github.com/Lenstra/terraform-provider-logto/internal/provider/roleconflicts"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.ResourceWithImportState = &roleAssignmentResource{}
+	_ resource.ResourceWithModifyPlan  = &roleAssignmentResource{}
+)
+
+// members lists, assigns and removes the members of one kind of a role.
+type members struct {
+	kind   string
+	list   func(ctx context.Context, roleId string) ([]string, error)
+	add    func(ctx context.Context, roleId string, ids []string) error
+	remove func(ctx context.Context, roleId string, id string) error
+}
+
+func (r *roleAssignmentResource) users() members {
+	return members{
+		kind: roleconflicts.KindUser,
+		list: func(ctx context.Context, roleId string) ([]string, error) {
+			ids := []string{}
+			for user, err := range r.client.RoleUsersAll(ctx, roleId, nil) {
+				if err != nil {
+					return nil, err
+				}
+				ids = append(ids, user.ID)
+			}
+			return ids, nil
+		},
+		add: func(ctx context.Context, roleId string, ids []string) error {
+			return r.client.RoleUsersAdd(ctx, roleId, &client.UserIdsModel{UserIds: ids})
+		},
+		remove: r.client.RoleUserRemove,
+	}
+}
+
+func (r *roleAssignmentResource) applications() members {
+	return members{
+		kind: roleconflicts.KindApplication,
+		list: func(ctx context.Context, roleId string) ([]string, error) {
+			ids := []string{}
+			for app, err := range r.client.RoleApplicationsAll(ctx, roleId, nil) {
+				if err != nil {
+					return nil, err
+				}
+				ids = append(ids, app.ID)
+			}
+			return ids, nil
+		},
+		add: func(ctx context.Context, roleId string, ids []string) error {
+			return r.client.RoleApplicationsAdd(ctx, roleId, &client.ApplicationIdsModel{ApplicationIds: ids})
+		},
+		remove: r.client.RoleApplicationRemove,
+	}
+}
+
+func (r *roleAssignmentResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan RoleAssignmentModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state, diags := r.apply(ctx, plan, RoleAssignmentModel{
+		UserIds:        types.SetNull(types.StringType),
+		ApplicationIds: types.SetNull(types.StringType),
+	})
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *roleAssignmentResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state RoleAssignmentModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	roleId := state.RoleId.ValueString()
+	role, err := r.client.RoleGet(ctx, roleId)
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading role", err.Error())
+		return
+	}
+
+	if role == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	// Only the kinds of members that are managed are refreshed.
+	userIds, diags := r.refresh(ctx, roleId, r.users(), state.UserIds)
+	resp.Diagnostics.Append(diags...)
+	applicationIds, diags := r.refresh(ctx, roleId, r.applications(), state.ApplicationIds)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, convertToTerraformModel(roleId, userIds, applicationIds))
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *roleAssignmentResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, prior RoleAssignmentModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &prior)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state, diags := r.apply(ctx, plan, prior)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *roleAssignmentResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state RoleAssignmentModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Only the members of the state are removed, the members added since the
+	// last refresh are not known to be managed.
+	_, diags = r.apply(ctx, RoleAssignmentModel{
+		RoleId:         state.RoleId,
+		UserIds:        types.SetNull(types.StringType),
+		ApplicationIds: types.SetNull(types.StringType),
+	}, state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// ImportState manages the members that can be given the role: the users for
+// roles of type User and the applications for machine-to-machine roles.
+func (r *roleAssignmentResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	role, err := r.client.RoleGet(ctx, req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading role", err.Error())
+		return
+	}
+	if role == nil {
+		resp.Diagnostics.AddError("Error importing role assignment", fmt.Sprintf("The role %q does not exist.", req.ID))
+		return
+	}
+
+	managed := path.Root("user_ids")
+	if role.Type == "MachineToMachine" {
+		managed = path.Root("application_ids")
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("role_id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, managed, types.SetValueMust(types.StringType, []attr.Value{}))...)
+}
+
+// ModifyPlan warns about the assignments that are also managed by another
+// resource.
+func (r *roleAssignmentResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan RoleAssignmentModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() || plan.RoleId.IsUnknown() {
+		return
+	}
+
+	roleIds := types.SetValueMust(types.StringType, []attr.Value{plan.RoleId})
+	for _, m := range []struct {
+		kind string
+		ids  types.Set
+	}{
+		{roleconflicts.KindUser, plan.UserIds},
+		{roleconflicts.KindApplication, plan.ApplicationIds},
+	} {
+		if m.ids.IsNull() || m.ids.IsUnknown() {
+			continue
+		}
+		var ids []types.String
+		resp.Diagnostics.Append(m.ids.ElementsAs(ctx, &ids, false)...)
+		for _, id := range ids {
+			resp.Diagnostics.Append(roleconflicts.ClaimPlanned(ctx, r.client, "logto_role_assignment", m.kind, id, roleIds)...)
+		}
+	}
+}
+
+// apply assigns and removes the members of the role so that they match the
+// plan. The members of the kinds that are no longer managed are removed from
+// the role, as if the resource had been destroyed.
+func (r *roleAssignmentResource) apply(ctx context.Context, plan, prior RoleAssignmentModel) (RoleAssignmentModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	roleId := plan.RoleId.ValueString()
+
+	userIds, d := r.sync(ctx, roleId, r.users(), plan.UserIds, prior.UserIds)
+	diags.Append(d...)
+	if diags.HasError() {
+		return plan, diags
+	}
+	applicationIds, d := r.sync(ctx, roleId, r.applications(), plan.ApplicationIds, prior.ApplicationIds)
+	diags.Append(d...)
+	if diags.HasError() {
+		return plan, diags
+	}
+
+	return convertToTerraformModel(roleId, userIds, applicationIds), diags
+}
+
+// sync makes planned the members of the role. When planned is null the
+// members found in prior are removed and the others are left untouched.
+func (r *roleAssignmentResource) sync(ctx context.Context, roleId string, m members, planned, prior types.Set) ([]string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	if planned.IsNull() && prior.IsNull() {
+		return nil, diags
+	}
+
+	current, err := m.list(ctx, roleId)
+	if err != nil {
+		diags.AddError(fmt.Sprintf("Error reading %ss of role", m.kind), err.Error())
+		return nil, diags
+	}
+
+	var wanted []string
+	if !planned.IsNull() {
+		wanted = []string{}
+		diags.Append(planned.ElementsAs(ctx, &wanted, false)...)
+	}
+	var removed []string
+	if planned.IsNull() {
+		diags.Append(prior.ElementsAs(ctx, &removed, false)...)
+	}
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	var toAdd []string
+	for _, id := range wanted {
+		if !slices.Contains(current, id) {
+			toAdd = append(toAdd, id)
+		}
+	}
+	if len(toAdd) != 0 {
+		if err := m.add(ctx, roleId, toAdd); err != nil {
+			diags.AddError(fmt.Sprintf("Error assigning role to %s(s)", m.kind), err.Error())
+			return nil, diags
+		}
+	}
+
+	for _, id := range current {
+		if slices.Contains(wanted, id) || (planned.IsNull() && !slices.Contains(removed, id)) {
+			continue
+		}
+		if err := m.remove(ctx, roleId, id); err != nil {
+			diags.AddError(fmt.Sprintf("Error removing role from %s", m.kind), err.Error())
+			return nil, diags
+		}
+	}
+
+	return wanted, diags
+}
+
+// refresh returns the current members of the role, or nil when this kind of
+// members is not managed.
+func (r *roleAssignmentResource) refresh(ctx context.Context, roleId string, m members, state types.Set) ([]string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	if state.IsNull() {
+		return nil, diags
+	}
+
+	ids, err := m.list(ctx, roleId)
+	if err != nil {
+		diags.AddError(fmt.Sprintf("Error reading %ss of role", m.kind), err.Error())
+		return nil, diags
+	}
+	return ids, diags
+}
+
+func convertToTerraformModel(roleId string, userIds, applicationIds []string) RoleAssignmentModel {
+	return RoleAssignmentModel{
+		Id:             types.StringValue(roleId),
+		RoleId:         types.StringValue(roleId),
+		UserIds:        stringSet(userIds),
+		ApplicationIds: stringSet(applicationIds),
+	}
+}
+
+// stringSet returns a set holding values, or a null set when values is nil.
+func stringSet(values []string) types.Set {
+	if values == nil {
+		return types.SetNull(types.StringType)
+	}
+
+	elements := make([]attr.Value, 0, len(values))
+	for _, value := range values {
+		elements = append(elements, types.StringValue(value))
+	}
+	return types.SetValueMust(types.StringType, elements)
+}
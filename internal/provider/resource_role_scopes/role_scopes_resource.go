@@ -0,0 +1,223 @@
+package resource_role_scopes
+
+import (
+	"context"
+	"slices"
+
+	"github.com/Lenstra/terraform-provider-logto/client"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.ResourceWithImportState = &roleScopesResource{}
+)
+
+func (r *roleScopesResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan RoleScopesModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state, diags := r.apply(ctx, plan, nil)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *roleScopesResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state RoleScopesModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	roleId := state.RoleId.ValueString()
+	role, err := r.client.RoleGet(ctx, roleId)
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading role", err.Error())
+		return
+	}
+
+	if role == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	current, err := r.scopeIds(ctx, roleId)
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading role scopes", err.Error())
+		return
+	}
+
+	// Only the scopes managed by this resource are compared, unless it has
+	// just been imported.
+	scopeIds := current
+	if !state.ScopeIds.IsNull() {
+		managed, diags := decodeScopeIds(ctx, state.ScopeIds)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		scopeIds = intersect(managed, current)
+	}
+
+	diags = resp.State.Set(ctx, convertToTerraformModel(roleId, scopeIds))
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *roleScopesResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, prior RoleScopesModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &prior)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	previous, diags := decodeScopeIds(ctx, prior.ScopeIds)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state, diags := r.apply(ctx, plan, previous)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *roleScopesResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state RoleScopesModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	roleId := state.RoleId.ValueString()
+	managed, diags := decodeScopeIds(ctx, state.ScopeIds)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	current, err := r.scopeIds(ctx, roleId)
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading role scopes", err.Error())
+		return
+	}
+
+	for _, scopeId := range intersect(managed, current) {
+		err := r.client.RoleScopeRemove(ctx, roleId, scopeId)
+		if err != nil {
+			resp.Diagnostics.AddError("Error removing scope from role", err.Error())
+			return
+		}
+	}
+}
+
+func (r *roleScopesResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("role_id"), req.ID)...)
+}
+
+// apply adds the planned scopes missing from the role and removes the scopes
+// of previous that are no longer planned.
+func (r *roleScopesResource) apply(ctx context.Context, plan RoleScopesModel, previous []string) (RoleScopesModel, diag.Diagnostics) {
+	roleId := plan.RoleId.ValueString()
+	planned, diags := decodeScopeIds(ctx, plan.ScopeIds)
+	if diags.HasError() {
+		return plan, diags
+	}
+
+	current, err := r.scopeIds(ctx, roleId)
+	if err != nil {
+		diags.AddError("Error reading role scopes", err.Error())
+		return plan, diags
+	}
+
+	var toAdd []string
+	for _, scopeId := range planned {
+		if !slices.Contains(current, scopeId) {
+			toAdd = append(toAdd, scopeId)
+		}
+	}
+	if len(toAdd) != 0 {
+		err = r.client.RoleScopesAdd(ctx, roleId, &client.ScopeIdsModel{ScopeIds: toAdd})
+		if err != nil {
+			diags.AddError("Error adding scope(s) to role", err.Error())
+			return plan, diags
+		}
+	}
+
+	for _, scopeId := range intersect(previous, current) {
+		if slices.Contains(planned, scopeId) {
+			continue
+		}
+		err = r.client.RoleScopeRemove(ctx, roleId, scopeId)
+		if err != nil {
+			diags.AddError("Error removing scope from role", err.Error())
+			return plan, diags
+		}
+	}
+
+	return convertToTerraformModel(roleId, planned), diags
+}
+
+// scopeIds returns the identifiers of all the scopes of the role.
+func (r *roleScopesResource) scopeIds(ctx context.Context, roleId string) ([]string, error) {
+	scopeIds := []string{}
+	for scope, err := range r.client.RoleScopesAll(ctx, roleId, nil) {
+		if err != nil {
+			return nil, err
+		}
+		scopeIds = append(scopeIds, scope.ID)
+	}
+	return scopeIds, nil
+}
+
+func decodeScopeIds(ctx context.Context, set types.Set) ([]string, diag.Diagnostics) {
+	scopeIds := []string{}
+	if set.IsNull() || set.IsUnknown() {
+		return scopeIds, nil
+	}
+	diags := set.ElementsAs(ctx, &scopeIds, false)
+	return scopeIds, diags
+}
+
+func intersect(a, b []string) []string {
+	res := []string{}
+	for _, value := range a {
+		if slices.Contains(b, value) {
+			res = append(res, value)
+		}
+	}
+	return res
+}
+
+func convertToTerraformModel(roleId string, scopeIds []string) RoleScopesModel {
+	scopes := make([]attr.Value, 0, len(scopeIds))
+	for _, scopeId := range scopeIds {
+		scopes = append(scopes, types.StringValue(scopeId))
+	}
+
+	return RoleScopesModel{
+		Id:       types.StringValue(roleId),
+		RoleId:   types.StringValue(roleId),
+		ScopeIds: types.SetValueMust(types.StringType, scopes),
+	}
+}
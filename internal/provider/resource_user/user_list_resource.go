@@ -0,0 +1,162 @@
+package resource_user
+
+import (
+	"context"
+
+	"github.com/Lenstra/terraform-provider-logto/client"
+	"github.com/Lenstra/terraform-provider-logto/internal/provider/identity"
+	"github.com/Lenstra/terraform-provider-logto/internal/provider/listing"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ list.ListResourceWithConfigure = &userListResource{}
+)
+
+type userListResource struct {
+	client *client.Client
+}
+
+func UserListResource() list.ListResource {
+	return &userListResource{}
+}
+
+type UserListModel struct {
+	Search          types.String `tfsdk:"search"`
+	Username        types.String `tfsdk:"username"`
+	PrimaryEmail    types.String `tfsdk:"primary_email"`
+	PrimaryPhone    types.String `tfsdk:"primary_phone"`
+	Name            types.String `tfsdk:"name"`
+	MatchMode       types.String `tfsdk:"match_mode"`
+	JointMode       types.String `tfsdk:"joint_mode"`
+	IsCaseSensitive types.Bool   `tfsdk:"is_case_sensitive"`
+}
+
+func (r *userListResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_user"
+}
+
+func (r *userListResource) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	field := func(name string) schema.StringAttribute {
+		return schema.StringAttribute{
+			Optional:            true,
+			Description:         "Only list the users whose " + name + " matches this value.",
+			MarkdownDescription: "Only list the users whose " + name + " matches this value.",
+		}
+	}
+
+	resp.Schema = schema.Schema{
+		Description:         "Lists the users of the tenant, optionally filtered with the search of Logto.",
+		MarkdownDescription: "Lists the users of the tenant, optionally filtered with the search of Logto.",
+		Attributes: map[string]schema.Attribute{
+			"search": schema.StringAttribute{
+				Optional:            true,
+				Description:         "Only list the users whose identifier, username, primary email, primary phone or name matches this value.",
+				MarkdownDescription: "Only list the users whose identifier, username, primary email, primary phone or name matches this value.",
+			},
+			"username":      field("username"),
+			"primary_email": field("primary email"),
+			"primary_phone": field("primary phone, in the E.164 format,"),
+			"name":          field("name"),
+			"match_mode": schema.StringAttribute{
+				Optional:            true,
+				Description:         "How the values are matched: like (the default, where % matches any sequence of characters and _ any single character), exact, similar_to or posix (a regular expression).",
+				MarkdownDescription: "How the values are matched: `like` (the default, where `%` matches any sequence of characters and `_` any single character), `exact`, `similar_to` or `posix` (a regular expression).",
+				Validators: []validator.String{
+					stringvalidator.OneOf("like", "exact", "similar_to", "posix"),
+				},
+			},
+			"joint_mode": schema.StringAttribute{
+				Optional:            true,
+				Description:         "Whether the users must match all the filters (and) or any of them (or, the default).",
+				MarkdownDescription: "Whether the users must match all the filters (`and`) or any of them (`or`, the default).",
+				Validators: []validator.String{
+					stringvalidator.OneOf("and", "or"),
+				},
+			},
+			"is_case_sensitive": schema.BoolAttribute{
+				Optional:            true,
+				Description:         "Whether the values are matched case sensitively, defaults to false.",
+				MarkdownDescription: "Whether the values are matched case sensitively, defaults to `false`.",
+			},
+		},
+	}
+}
+
+func (r *userListResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		return
+	}
+	r.client = client
+}
+
+func (r *userListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var config UserListModel
+	diags := req.Config.Get(ctx, &config)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	params := map[string]string{}
+	for key, value := range map[string]types.String{
+		"search":              config.Search,
+		"search.username":     config.Username,
+		"search.primaryEmail": config.PrimaryEmail,
+		"search.name":         config.Name,
+		"mode":                config.MatchMode,
+		"joint":               config.JointMode,
+	} {
+		if !value.IsNull() {
+			params[key] = value.ValueString()
+		}
+	}
+	if !config.PrimaryPhone.IsNull() {
+		params["search.primaryPhone"] = phoneToLogto(config.PrimaryPhone.ValueString())
+	}
+	if !config.IsCaseSensitive.IsNull() {
+		params["isCaseSensitive"] = config.IsCaseSensitive.String()
+	}
+
+	stream.Results = listing.Results(ctx, req, r.client.UsersAll(ctx, params), "Error listing users", func(user client.UserModel, result *list.ListResult) {
+		result.DisplayName = displayName(user)
+		result.Diagnostics.Append(identity.Set(ctx, result.Identity, user.ID)...)
+		if !req.IncludeResource {
+			return
+		}
+
+		// The users are converted as if they had just been imported.
+		var model UserModel
+		result.Diagnostics.Append(convertToTerraformModel(ctx, &user, nil, UserModel{
+			CustomDataKeys:     types.SetNull(types.StringType),
+			DeletionProtection: types.BoolNull(),
+			PasswordAlgorithm:  types.StringNull(),
+			PasswordDigest:     types.StringNull(),
+			PasswordVersion:    types.Int64Null(),
+		}, &model)...)
+		if result.Diagnostics.HasError() {
+			return
+		}
+		result.Diagnostics.Append(result.Resource.Set(ctx, model)...)
+	})
+}
+
+// displayName returns the first of the username, the primary email and the
+// name of the user that is set, or its identifier.
+func displayName(user client.UserModel) string {
+	for _, name := range []string{user.Username, user.PrimaryEmail, user.Name} {
+		if name != "" {
+			return name
+		}
+	}
+	return user.ID
+}
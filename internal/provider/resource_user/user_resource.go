@@ -2,12 +2,28 @@ package resource_user
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"strings"
 
 	"github.com/Lenstra/terraform-provider-logto/client"
+	"github.com/Lenstra/terraform-provider-logto/internal/provider/customdata"
+	"github.com/Lenstra/terraform-provider-logto/internal/provider/identity"
+	"github.com/Lenstra/terraform-provider-logto/internal/provider/roleconflicts"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+var (
+	_ resource.ResourceWithValidateConfig = &userResource{}
+	_ resource.ResourceWithModifyPlan     = &userResource{}
+	_ resource.ResourceWithImportState    = &userResource{}
+	_ resource.ResourceWithIdentity       = &userResource{}
 )
 
 func (r *userResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -17,23 +33,57 @@ func (r *userResource) Create(ctx context.Context, req resource.CreateRequest, r
 	if resp.Diagnostics.HasError() {
 		return
 	}
-	user, roleIds, diags := decodePlan(ctx, plan)
+	user, roleIds, diags := decodePlan(ctx, plan, nil)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	// The password is write-only so it is only available in the
+	// configuration, and Logto only accepts digests on creation.
+	var password types.String
+	diags = req.Config.GetAttribute(ctx, path.Root("password"), &password)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	user.Password = password.ValueString()
+	user.PasswordDigest = plan.PasswordDigest.ValueString()
+	user.PasswordAlgorithm = plan.PasswordAlgorithm.ValueString()
+
+	// There is nothing to remove from a new user, and Logto refuses empty
+	// phone numbers on creation.
+	if user.PrimaryPhone != nil && *user.PrimaryPhone == "" {
+		user.PrimaryPhone = nil
+	}
+	if user.Avatar != nil && *user.Avatar == "" {
+		user.Avatar = nil
+	}
+
 	user, err := r.client.UserCreate(ctx, user)
 	if err != nil {
 		resp.Diagnostics.AddError("Error creating user", err.Error())
 		return
 	}
+	resp.Diagnostics.Append(identity.Set(ctx, resp.Identity, user.ID)...)
 
 	// Put the user into the state before assigning roles in case of error during roles assignment
-	convertToTerraformModel(ctx, user, nil, &state)
+	diags = convertToTerraformModel(ctx, user, nil, plan, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 	diags = resp.State.Set(ctx, state)
 	resp.Diagnostics.Append(diags...)
 
+	if plan.IsSuspended.ValueBool() {
+		user, err = r.client.UserIsSuspendedUpdate(ctx, user.ID, true)
+		if err != nil {
+			resp.Diagnostics.AddError("Error suspending user", err.Error())
+			return
+		}
+	}
+
 	if roleIds != nil {
 		err = r.client.AssignRolesForUser(ctx, roleIds, user.ID)
 		if err != nil {
@@ -42,7 +92,11 @@ func (r *userResource) Create(ctx context.Context, req resource.CreateRequest, r
 		}
 	}
 
-	convertToTerraformModel(ctx, user, roleIds, &state)
+	diags = convertToTerraformModel(ctx, user, roleIds, plan, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 	diags = resp.State.Set(ctx, state)
 	resp.Diagnostics.Append(diags...)
 }
@@ -54,6 +108,7 @@ func (r *userResource) Read(ctx context.Context, req resource.ReadRequest, resp
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	resp.Diagnostics.Append(identity.Set(ctx, resp.Identity, state.Id.ValueString())...)
 
 	user, err := r.client.UserGet(ctx, state.Id.ValueString())
 	if err != nil {
@@ -80,7 +135,11 @@ func (r *userResource) Read(ctx context.Context, req resource.ReadRequest, resp
 		}
 	}
 
-	convertToTerraformModel(ctx, user, rolesIds, &state)
+	diags = convertToTerraformModel(ctx, user, rolesIds, state, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 	diags = resp.State.Set(ctx, state)
 	resp.Diagnostics.Append(diags...)
 }
@@ -92,7 +151,20 @@ func (r *userResource) Update(ctx context.Context, req resource.UpdateRequest, r
 	if resp.Diagnostics.HasError() {
 		return
 	}
-	user, roleIds, diags := decodePlan(ctx, plan)
+
+	var current json.RawMessage
+	if !plan.CustomDataKeys.IsNull() {
+		user, err := r.client.UserGet(ctx, plan.Id.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("Error reading user", err.Error())
+			return
+		}
+		if user != nil {
+			current = user.CustomData
+		}
+	}
+
+	user, roleIds, diags := decodePlan(ctx, plan, current)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -103,6 +175,33 @@ func (r *userResource) Update(ctx context.Context, req resource.UpdateRequest, r
 		resp.Diagnostics.AddError("Error updating user", err.Error())
 		return
 	}
+	resp.Diagnostics.Append(identity.Set(ctx, resp.Identity, user.ID)...)
+
+	var password types.String
+	var passwordVersion types.Int64
+	diags = req.Config.GetAttribute(ctx, path.Root("password"), &password)
+	resp.Diagnostics.Append(diags...)
+	diags = req.State.GetAttribute(ctx, path.Root("password_version"), &passwordVersion)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !password.IsNull() && !plan.PasswordVersion.Equal(passwordVersion) {
+		user, err = r.client.UserPasswordUpdate(ctx, user.ID, password.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("Error updating password of user", err.Error())
+			return
+		}
+	}
+
+	if !plan.IsSuspended.IsUnknown() && plan.IsSuspended.ValueBool() != user.IsSuspended {
+		user, err = r.client.UserIsSuspendedUpdate(ctx, user.ID, plan.IsSuspended.ValueBool())
+		if err != nil {
+			resp.Diagnostics.AddError("Error updating suspension of user", err.Error())
+			return
+		}
+	}
 
 	if roleIds != nil {
 		err := r.client.UpdateRolesForUser(ctx, roleIds, user.ID)
@@ -112,7 +211,11 @@ func (r *userResource) Update(ctx context.Context, req resource.UpdateRequest, r
 		}
 	}
 
-	convertToTerraformModel(ctx, user, roleIds, &state)
+	diags = convertToTerraformModel(ctx, user, roleIds, plan, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 	diags = resp.State.Set(ctx, &state)
 	resp.Diagnostics.Append(diags...)
 }
@@ -125,6 +228,19 @@ func (r *userResource) Delete(ctx context.Context, req resource.DeleteRequest, r
 		return
 	}
 
+	if state.DeletionProtection.ValueBool() {
+		_, err := r.client.UserIsSuspendedUpdate(ctx, state.Id.ValueString(), true)
+		if err != nil {
+			resp.Diagnostics.AddError("Error suspending user", err.Error())
+			return
+		}
+		resp.Diagnostics.AddWarning(
+			"User suspended instead of deleted",
+			fmt.Sprintf("deletion_protection is enabled for user %q so it was suspended and kept in Logto.", state.Id.ValueString()),
+		)
+		return
+	}
+
 	if !state.RoleIds.IsNull() && !state.RoleIds.IsUnknown() {
 		roleIdslist, diag := convertSetToSlice(state.RoleIds)
 		resp.Diagnostics.Append(diag...)
@@ -146,7 +262,9 @@ func (r *userResource) Delete(ctx context.Context, req resource.DeleteRequest, r
 	}
 }
 
-func decodePlan(_ context.Context, plan UserModel) (*client.UserModel, *client.RoleIdsModel, diag.Diagnostics) {
+// decodePlan returns the user to send to Logto, current holds its custom data
+// when only some of its keys are managed by Terraform.
+func decodePlan(ctx context.Context, plan UserModel, current json.RawMessage) (*client.UserModel, *client.RoleIdsModel, diag.Diagnostics) {
 	var clientRolIds *client.RoleIdsModel
 
 	if !plan.RoleIds.IsNull() && !plan.RoleIds.IsUnknown() {
@@ -162,34 +280,99 @@ func decodePlan(_ context.Context, plan UserModel) (*client.UserModel, *client.R
 	user := &client.UserModel{
 		ID:           plan.Id.ValueString(),
 		PrimaryEmail: plan.PrimaryEmail.ValueString(),
+		PrimaryPhone: decodeOptionalString(plan.PrimaryPhone, phoneToLogto),
 		Username:     plan.Username.ValueString(),
 		Name:         plan.Name.ValueString(),
-		Profile: &client.Profile{
-			FamilyName: plan.Profile.FamilyName.ValueString(),
-			GivenName:  plan.Profile.GivenName.ValueString(),
-			MiddleName: plan.Profile.MiddleName.ValueString(),
-			Nickname:   plan.Profile.Nickname.ValueString(),
-		},
+		Avatar:       decodeOptionalString(plan.Avatar, nil),
+	}
+
+	// Logto replaces the whole profile, it is left untouched when it is not
+	// configured.
+	if !plan.Profile.IsNull() && !plan.Profile.IsUnknown() {
+		address, diags := decodeAddress(ctx, plan.Profile.Address)
+		if diags.HasError() {
+			return nil, nil, diags
+		}
+		user.Profile = &client.Profile{
+			FamilyName:        plan.Profile.FamilyName.ValueString(),
+			GivenName:         plan.Profile.GivenName.ValueString(),
+			MiddleName:        plan.Profile.MiddleName.ValueString(),
+			Nickname:          plan.Profile.Nickname.ValueString(),
+			PreferredUsername: plan.Profile.PreferredUsername.ValueString(),
+			Profile:           plan.Profile.Profile.ValueString(),
+			Website:           plan.Profile.Website.ValueString(),
+			Gender:            plan.Profile.Gender.ValueString(),
+			Birthdate:         plan.Profile.Birthdate.ValueString(),
+			Zoneinfo:          plan.Profile.Zoneinfo.ValueString(),
+			Locale:            plan.Profile.Locale.ValueString(),
+			Address:           address,
+		}
+	}
+
+	var diags diag.Diagnostics
+	user.CustomData, diags = customdata.Expand(ctx, plan.CustomData, plan.CustomDataKeys, current)
+	if diags.HasError() {
+		return nil, nil, diags
 	}
 
 	return user, clientRolIds, nil
 }
 
-func convertToTerraformModel(_ context.Context, user *client.UserModel, roleIds *client.RoleIdsModel, model *UserModel) {
+// convertToTerraformModel converts the user returned by Logto, the attributes
+// that Logto does not return are taken from prior.
+func convertToTerraformModel(ctx context.Context, user *client.UserModel, roleIds *client.RoleIdsModel, prior UserModel, model *UserModel) (diags diag.Diagnostics) {
 	*model = UserModel{
-		Id:           types.StringValue(user.ID),
-		PrimaryEmail: types.StringValue(user.PrimaryEmail),
-		Username:     types.StringValue(user.Username),
-		Name:         types.StringValue(user.Name),
+		Id:             types.StringValue(user.ID),
+		PrimaryEmail:   types.StringValue(user.PrimaryEmail),
+		PrimaryPhone:   convertOptionalString(user.PrimaryPhone, prior.PrimaryPhone, phoneFromLogto),
+		Avatar:         convertOptionalString(user.Avatar, prior.Avatar, nil),
+		Username:       types.StringValue(user.Username),
+		Name:           types.StringValue(user.Name),
+		CustomDataKeys: prior.CustomDataKeys,
+		IsSuspended:    types.BoolValue(user.IsSuspended),
+		HasPassword:    types.BoolValue(user.HasPassword),
+		CreatedAt:      numberValue(user.CreatedAt),
+		UpdatedAt:      numberValue(user.UpdatedAt),
+		LastSignInAt:   numberValue(user.LastSignInAt),
+
+		DeletionProtection: prior.DeletionProtection,
+
+		Password:          types.StringNull(),
+		PasswordAlgorithm: prior.PasswordAlgorithm,
+		PasswordDigest:    prior.PasswordDigest,
+		PasswordVersion:   prior.PasswordVersion,
+	}
+
+	// Imported users have no prior value.
+	if model.DeletionProtection.IsNull() {
+		model.DeletionProtection = types.BoolValue(false)
+	}
+
+	model.CustomData, diags = customdata.Flatten(ctx, user.CustomData, prior.CustomDataKeys)
+	if diags.HasError() {
+		return
 	}
 
 	if user.Profile != nil {
+		address, d := convertAddress(ctx, user.Profile.Address, prior.Profile.Address)
+		diags.Append(d...)
+		if diags.HasError() {
+			return
+		}
 		model.Profile = ProfileValue{
-			FamilyName: types.StringValue(user.Profile.FamilyName),
-			GivenName:  types.StringValue(user.Profile.GivenName),
-			MiddleName: types.StringValue(user.Profile.MiddleName),
-			Nickname:   types.StringValue(user.Profile.Nickname),
-			state:      attr.ValueStateKnown,
+			FamilyName:        optionalString(user.Profile.FamilyName, prior.Profile.FamilyName),
+			GivenName:         optionalString(user.Profile.GivenName, prior.Profile.GivenName),
+			MiddleName:        optionalString(user.Profile.MiddleName, prior.Profile.MiddleName),
+			Nickname:          optionalString(user.Profile.Nickname, prior.Profile.Nickname),
+			PreferredUsername: optionalString(user.Profile.PreferredUsername, prior.Profile.PreferredUsername),
+			Profile:           optionalString(user.Profile.Profile, prior.Profile.Profile),
+			Website:           optionalString(user.Profile.Website, prior.Profile.Website),
+			Gender:            optionalString(user.Profile.Gender, prior.Profile.Gender),
+			Birthdate:         optionalString(user.Profile.Birthdate, prior.Profile.Birthdate),
+			Zoneinfo:          optionalString(user.Profile.Zoneinfo, prior.Profile.Zoneinfo),
+			Locale:            optionalString(user.Profile.Locale, prior.Profile.Locale),
+			Address:           address,
+			state:             attr.ValueStateKnown,
 		}
 	}
 
@@ -202,6 +385,109 @@ func convertToTerraformModel(_ context.Context, user *client.UserModel, roleIds
 		}
 		model.RoleIds = types.SetValueMust(types.StringType, roleVals)
 	}
+
+	return
+}
+
+// decodeAddress returns the address to send to Logto, or nil when it is not
+// set.
+func decodeAddress(ctx context.Context, value basetypes.ObjectValue) (*client.Address, diag.Diagnostics) {
+	if value.IsNull() || value.IsUnknown() {
+		return nil, nil
+	}
+
+	object, diags := AddressType{}.ValueFromObject(ctx, value)
+	if diags.HasError() {
+		return nil, diags
+	}
+	address := object.(AddressValue)
+
+	return &client.Address{
+		Formatted:     address.Formatted.ValueString(),
+		StreetAddress: address.StreetAddress.ValueString(),
+		Locality:      address.Locality.ValueString(),
+		Region:        address.Region.ValueString(),
+		PostalCode:    address.PostalCode.ValueString(),
+		Country:       address.Country.ValueString(),
+	}, diags
+}
+
+func convertAddress(ctx context.Context, address *client.Address, priorValue basetypes.ObjectValue) (basetypes.ObjectValue, diag.Diagnostics) {
+	if address == nil {
+		return types.ObjectNull(AddressValue{}.AttributeTypes(ctx)), nil
+	}
+
+	var prior AddressValue
+	if !priorValue.IsNull() && !priorValue.IsUnknown() {
+		object, diags := AddressType{}.ValueFromObject(ctx, priorValue)
+		if diags.HasError() {
+			return types.ObjectNull(AddressValue{}.AttributeTypes(ctx)), diags
+		}
+		prior = object.(AddressValue)
+	}
+
+	return AddressValue{
+		Country:       optionalString(address.Country, prior.Country),
+		Formatted:     optionalString(address.Formatted, prior.Formatted),
+		Locality:      optionalString(address.Locality, prior.Locality),
+		PostalCode:    optionalString(address.PostalCode, prior.PostalCode),
+		Region:        optionalString(address.Region, prior.Region),
+		StreetAddress: optionalString(address.StreetAddress, prior.StreetAddress),
+		state:         attr.ValueStateKnown,
+	}.ToObjectValue(ctx)
+}
+
+// decodeOptionalString returns the value to send to Logto for an attribute
+// that Logto can remove: nil leaves it untouched and an empty string removes
+// it. format converts the value to the format used by Logto when not nil.
+func decodeOptionalString(value types.String, format func(string) string) *string {
+	if value.IsUnknown() {
+		return nil
+	}
+
+	res := value.ValueString()
+	if format != nil && res != "" {
+		res = format(res)
+	}
+	return &res
+}
+
+// convertOptionalString is the reverse of decodeOptionalString, Logto may
+// report removed values either as null or as empty strings.
+func convertOptionalString(value *string, prior types.String, format func(string) string) types.String {
+	if value == nil {
+		return optionalString("", prior)
+	}
+	if format != nil && *value != "" {
+		return optionalString(format(*value), prior)
+	}
+	return optionalString(*value, prior)
+}
+
+// optionalString returns null for the attributes that Logto does not set,
+// unless they are set to an empty string in prior so that the configurations
+// and the states using empty strings are left unchanged.
+func optionalString(value string, prior types.String) types.String {
+	if value == "" && (prior.IsNull() || prior.IsUnknown() || prior.ValueString() != "") {
+		return types.StringNull()
+	}
+	return types.StringValue(value)
+}
+
+// Logto stores the phone numbers without the leading "+" of the E.164 format.
+func phoneToLogto(phone string) string {
+	return strings.TrimPrefix(phone, "+")
+}
+
+func phoneFromLogto(phone string) string {
+	return "+" + strings.TrimPrefix(phone, "+")
+}
+
+func numberValue(value *float64) types.Number {
+	if value == nil {
+		return types.NumberNull()
+	}
+	return types.NumberValue(big.NewFloat(*value))
 }
 
 func convertSetToSlice(set types.Set) ([]string, diag.Diagnostics) {
@@ -224,3 +510,108 @@ func convertSetToSlice(set types.Set) ([]string, diag.Diagnostics) {
 
 	return result, diags
 }
+
+func (r *userResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config UserModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(customdata.Validate(ctx, config.CustomData, config.CustomDataKeys)...)
+}
+
+// ModifyPlan rejects the changes of the password digest of existing users and
+// warns about the roles of role_ids that are also managed by another resource.
+func (r *userResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan UserModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !req.State.Raw.IsNull() {
+		var prior UserModel
+		diags = req.State.Get(ctx, &prior)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		resp.Diagnostics.Append(checkCreateOnly(path.Root("password_digest"), plan.PasswordDigest, prior.PasswordDigest)...)
+		resp.Diagnostics.Append(checkCreateOnly(path.Root("password_algorithm"), plan.PasswordAlgorithm, prior.PasswordAlgorithm)...)
+	}
+
+	resp.Diagnostics.Append(roleconflicts.ClaimPlanned(ctx, r.client, "the role_ids attribute of logto_user", roleconflicts.KindUser, plan.Id, plan.RoleIds)...)
+}
+
+// checkCreateOnly reports an error when the password digest attribute is
+// changed after the user is created, as Logto only accepts it on creation. It
+// can still be removed.
+func checkCreateOnly(attribute path.Path, planned, prior types.String) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if planned.IsNull() || planned.IsUnknown() || planned.Equal(prior) {
+		return diags
+	}
+
+	diags.AddAttributeError(
+		attribute,
+		"Cannot change the password digest of a user",
+		"Logto only accepts password_digest and password_algorithm when the user is created. "+
+			"Remove them from the configuration and use password and password_version to change the password of the user.",
+	)
+	return diags
+}
+
+func (r *userResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identity.Schema()
+}
+
+// ImportState accepts the identifier, the username or the primary email of the
+// user.
+func (r *userResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	id, diags := identity.ImportID(ctx, req)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	user, err := r.client.UserGet(ctx, id)
+	if err == nil && user == nil {
+		if strings.Contains(id, "@") {
+			user, err = r.find(ctx, id, func(u client.UserModel) string { return u.PrimaryEmail })
+		} else {
+			user, err = r.find(ctx, id, func(u client.UserModel) string { return u.Username })
+		}
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading user", err.Error())
+		return
+	}
+	if user == nil {
+		resp.Diagnostics.AddError("Cannot import user", fmt.Sprintf("No user has the identifier, the username or the primary email %q.", id))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), user.ID)...)
+	resp.Diagnostics.Append(identity.Set(ctx, resp.Identity, user.ID)...)
+}
+
+// find returns the user whose field, as returned by key, equals value. Both
+// usernames and primary emails are unique in Logto.
+func (r *userResource) find(ctx context.Context, value string, key func(client.UserModel) string) (*client.UserModel, error) {
+	for user, err := range r.client.UsersAll(ctx, map[string]string{"search": value}) {
+		if err != nil {
+			return nil, err
+		}
+		if key(user) == value {
+			return &user, nil
+		}
+	}
+	return nil, nil
+}
@@ -5,9 +5,14 @@ package resource_user
 import (
 	"context"
 	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/numberplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
@@ -23,16 +28,116 @@ import (
 func UserResourceSchema(ctx context.Context) schema.Schema {
 	return schema.Schema{
 		Attributes: map[string]schema.Attribute{
+			"avatar": schema.StringAttribute{
+				Optional:            true,
+				Description:         "The URL of the avatar of the user. When not set, the avatar of the user is removed, including when it was set outside of Terraform.",
+				MarkdownDescription: "The URL of the avatar of the user. When not set, the avatar of the user is removed, including when it was set outside of Terraform.",
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexp.MustCompile(`^https?://`), "must be an http or https URL"),
+				},
+			},
+			"created_at": schema.NumberAttribute{
+				Computed:            true,
+				Description:         "The creation time of the user, in milliseconds since the epoch.",
+				MarkdownDescription: "The creation time of the user, in milliseconds since the epoch.",
+				PlanModifiers: []planmodifier.Number{
+					numberplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"custom_data": schema.StringAttribute{
+				CustomType:          jsontypes.NormalizedType{},
+				Optional:            true,
+				Computed:            true,
+				Description:         "A JSON encoded object holding arbitrary data about the user.",
+				MarkdownDescription: "A JSON encoded object holding arbitrary data about the user.",
+			},
+			"custom_data_keys": schema.SetAttribute{
+				ElementType:         types.StringType,
+				Optional:            true,
+				Description:         "The top-level keys of `custom_data` managed by Terraform. When set, the other keys are left untouched so that they can be written by other clients, and the listed keys missing from `custom_data` are removed.",
+				MarkdownDescription: "The top-level keys of `custom_data` managed by Terraform. When set, the other keys are left untouched so that they can be written by other clients, and the listed keys missing from `custom_data` are removed.",
+			},
+			"deletion_protection": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Description:         "Whether the user is suspended instead of deleted when the resource is destroyed.",
+				MarkdownDescription: "Whether the user is suspended instead of deleted when the resource is destroyed.",
+				Default:             booldefault.StaticBool(false),
+			},
+			"has_password": schema.BoolAttribute{
+				Computed:            true,
+				Description:         "Whether the user has a password.",
+				MarkdownDescription: "Whether the user has a password.",
+			},
 			"id": schema.StringAttribute{
 				Computed: true,
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"is_suspended": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Description:         "Whether the user is suspended. A suspended user cannot sign in and all their sessions are revoked.",
+				MarkdownDescription: "Whether the user is suspended. A suspended user cannot sign in and all their sessions are revoked.",
+				Default:             booldefault.StaticBool(false),
+			},
+			"last_sign_in_at": schema.NumberAttribute{
+				Computed:            true,
+				Description:         "The last time the user signed in, in milliseconds since the epoch.",
+				MarkdownDescription: "The last time the user signed in, in milliseconds since the epoch.",
+				PlanModifiers: []planmodifier.Number{
+					numberplanmodifier.UseStateForUnknown(),
+				},
+			},
 			"name": schema.StringAttribute{
 				Optional: true,
 				Computed: true,
 			},
+			"password": schema.StringAttribute{
+				Optional:            true,
+				Sensitive:           true,
+				WriteOnly:           true,
+				Description:         "The password of the user. It is write-only and never stored in the state, change `password_version` to set it again.",
+				MarkdownDescription: "The password of the user. It is write-only and never stored in the state, change `password_version` to set it again.",
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("password_digest")),
+				},
+			},
+			"password_algorithm": schema.StringAttribute{
+				Optional:            true,
+				Description:         "The algorithm used to compute `password_digest`, one of `Argon2i`, `Argon2id`, `Argon2d`, `SHA1`, `SHA256`, `MD5` or `Bcrypt`.",
+				MarkdownDescription: "The algorithm used to compute `password_digest`, one of `Argon2i`, `Argon2id`, `Argon2d`, `SHA1`, `SHA256`, `MD5` or `Bcrypt`.",
+				Validators: []validator.String{
+					stringvalidator.OneOf(
+						"Argon2i",
+						"Argon2id",
+						"Argon2d",
+						"SHA1",
+						"SHA256",
+						"MD5",
+						"Bcrypt",
+					),
+					stringvalidator.AlsoRequires(path.MatchRoot("password_digest")),
+				},
+			},
+			"password_digest": schema.StringAttribute{
+				Optional:            true,
+				Sensitive:           true,
+				Description:         "The digest of a password hashed by another system, used to migrate users to Logto. Logto only accepts it when the user is created, it cannot be changed afterwards: remove it and use `password` and `password_version` to change the password.",
+				MarkdownDescription: "The digest of a password hashed by another system, used to migrate users to Logto. Logto only accepts it when the user is created, it cannot be changed afterwards: remove it and use `password` and `password_version` to change the password.",
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRoot("password_algorithm")),
+				},
+			},
+			"password_version": schema.Int64Attribute{
+				Optional:            true,
+				Description:         "An arbitrary value to change to set `password` again, as changes to write-only attributes cannot be detected.",
+				MarkdownDescription: "An arbitrary value to change to set `password` again, as changes to write-only attributes cannot be detected.",
+				Validators: []validator.Int64{
+					int64validator.AlsoRequires(path.MatchRoot("password")),
+				},
+			},
 			"primary_email": schema.StringAttribute{
 				Optional:            true,
 				Computed:            true,
@@ -42,16 +147,94 @@ func UserResourceSchema(ctx context.Context) schema.Schema {
 					stringvalidator.RegexMatches(regexp.MustCompile("^\\S+@\\S+\\.\\S+$"), ""),
 				},
 			},
+			"primary_phone": schema.StringAttribute{
+				Optional:            true,
+				Description:         "Primary phone number of the user in the E.164 format, for example `+33612345678`. It should be unique across all users. When not set, the phone number of the user is removed, including when it was set outside of Terraform.",
+				MarkdownDescription: "Primary phone number of the user in the E.164 format, for example `+33612345678`. It should be unique across all users. When not set, the phone number of the user is removed, including when it was set outside of Terraform.",
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexp.MustCompile(`^\+[1-9]\d{1,14}$`), "must be a phone number in the E.164 format"),
+				},
+			},
 			"profile": schema.SingleNestedAttribute{
 				Attributes: map[string]schema.Attribute{
+					"address": schema.SingleNestedAttribute{
+						Attributes: map[string]schema.Attribute{
+							"country": schema.StringAttribute{
+								Optional:            true,
+								Computed:            true,
+								Description:         "The country name component.",
+								MarkdownDescription: "The country name component.",
+							},
+							"formatted": schema.StringAttribute{
+								Optional:            true,
+								Computed:            true,
+								Description:         "The full mailing address, formatted for display.",
+								MarkdownDescription: "The full mailing address, formatted for display.",
+							},
+							"locality": schema.StringAttribute{
+								Optional:            true,
+								Computed:            true,
+								Description:         "The city or locality component.",
+								MarkdownDescription: "The city or locality component.",
+							},
+							"postal_code": schema.StringAttribute{
+								Optional:            true,
+								Computed:            true,
+								Description:         "The zip code or postal code component.",
+								MarkdownDescription: "The zip code or postal code component.",
+							},
+							"region": schema.StringAttribute{
+								Optional:            true,
+								Computed:            true,
+								Description:         "The state, province, prefecture or region component.",
+								MarkdownDescription: "The state, province, prefecture or region component.",
+							},
+							"street_address": schema.StringAttribute{
+								Optional:            true,
+								Computed:            true,
+								Description:         "The full street address component, which may include the house number, the street name, the post office box and multiple lines.",
+								MarkdownDescription: "The full street address component, which may include the house number, the street name, the post office box and multiple lines.",
+							},
+						},
+						CustomType: AddressType{
+							ObjectType: types.ObjectType{
+								AttrTypes: AddressValue{}.AttributeTypes(ctx),
+							},
+						},
+						Optional:            true,
+						Computed:            true,
+						Description:         "The postal address of the user.",
+						MarkdownDescription: "The postal address of the user.",
+					},
+					"birthdate": schema.StringAttribute{
+						Optional:            true,
+						Computed:            true,
+						Description:         "The birthday of the user, in the `YYYY-MM-DD` format.",
+						MarkdownDescription: "The birthday of the user, in the `YYYY-MM-DD` format.",
+						Validators: []validator.String{
+							stringvalidator.RegexMatches(regexp.MustCompile(`^\d{4}-\d{2}-\d{2}$`), "must be a date in the YYYY-MM-DD format"),
+						},
+					},
 					"family_name": schema.StringAttribute{
 						Optional: true,
 						Computed: true,
 					},
+					"gender": schema.StringAttribute{
+						Optional:            true,
+						Computed:            true,
+						Description:         "The gender of the user, for example `female` or `male`.",
+						MarkdownDescription: "The gender of the user, for example `female` or `male`.",
+					},
 					"given_name": schema.StringAttribute{
 						Optional: true,
 						Computed: true,
 					},
+					"locale": schema.StringAttribute{
+						Optional:            true,
+						Computed:            true,
+						Description:         "The locale of the user as a BCP 47 language tag, for example `fr-FR`.",
+						MarkdownDescription: "The locale of the user as a BCP 47 language tag, for example `fr-FR`.",
+					},
 					"middle_name": schema.StringAttribute{
 						Optional: true,
 						Computed: true,
@@ -60,6 +243,36 @@ func UserResourceSchema(ctx context.Context) schema.Schema {
 						Optional: true,
 						Computed: true,
 					},
+					"preferred_username": schema.StringAttribute{
+						Optional:            true,
+						Computed:            true,
+						Description:         "The shorthand name by which the user wishes to be referred to.",
+						MarkdownDescription: "The shorthand name by which the user wishes to be referred to.",
+					},
+					"profile": schema.StringAttribute{
+						Optional:            true,
+						Computed:            true,
+						Description:         "The URL of the profile page of the user.",
+						MarkdownDescription: "The URL of the profile page of the user.",
+						Validators: []validator.String{
+							stringvalidator.RegexMatches(regexp.MustCompile(`^https?://`), "must be an http or https URL"),
+						},
+					},
+					"website": schema.StringAttribute{
+						Optional:            true,
+						Computed:            true,
+						Description:         "The URL of the web page or blog of the user.",
+						MarkdownDescription: "The URL of the web page or blog of the user.",
+						Validators: []validator.String{
+							stringvalidator.RegexMatches(regexp.MustCompile(`^https?://`), "must be an http or https URL"),
+						},
+					},
+					"zoneinfo": schema.StringAttribute{
+						Optional:            true,
+						Computed:            true,
+						Description:         "The time zone of the user from the IANA time zone database, for example `Europe/Paris`.",
+						MarkdownDescription: "The time zone of the user from the IANA time zone database, for example `Europe/Paris`.",
+					},
 				},
 				CustomType: ProfileType{
 					ObjectType: types.ObjectType{
@@ -72,8 +285,13 @@ func UserResourceSchema(ctx context.Context) schema.Schema {
 			"role_ids": schema.SetAttribute{
 				ElementType:         types.StringType,
 				Optional:            true,
-				Description:         "An array of API resource role IDs to assign.",
-				MarkdownDescription: "An array of API resource role IDs to assign.",
+				Description:         "An array of API resource role IDs to assign. Every other role of the user is removed, use the `logto_user_roles` resource to only manage some of them. It must not be used along with `logto_user_roles`.",
+				MarkdownDescription: "An array of API resource role IDs to assign. Every other role of the user is removed, use the `logto_user_roles` resource to only manage some of them. It must not be used along with `logto_user_roles`.",
+			},
+			"updated_at": schema.NumberAttribute{
+				Computed:            true,
+				Description:         "The last time the user was updated, in milliseconds since the epoch.",
+				MarkdownDescription: "The last time the user was updated, in milliseconds since the epoch.",
 			},
 			"username": schema.StringAttribute{
 				Optional:            true,
@@ -88,23 +306,993 @@ func UserResourceSchema(ctx context.Context) schema.Schema {
 	}
 }
 
-type UserModel struct {
-	Id           types.String `tfsdk:"id"`
-	Name         types.String `tfsdk:"name"`
-	PrimaryEmail types.String `tfsdk:"primary_email"`
-	Profile      ProfileValue `tfsdk:"profile"`
-	RoleIds      types.Set    `tfsdk:"role_ids"`
-	Username     types.String `tfsdk:"username"`
+type UserModel struct {
+	Avatar             types.String         `tfsdk:"avatar"`
+	CreatedAt          types.Number         `tfsdk:"created_at"`
+	CustomData         jsontypes.Normalized `tfsdk:"custom_data"`
+	CustomDataKeys     types.Set            `tfsdk:"custom_data_keys"`
+	DeletionProtection types.Bool           `tfsdk:"deletion_protection"`
+	HasPassword        types.Bool           `tfsdk:"has_password"`
+	Id                 types.String         `tfsdk:"id"`
+	IsSuspended        types.Bool           `tfsdk:"is_suspended"`
+	LastSignInAt       types.Number         `tfsdk:"last_sign_in_at"`
+	Name               types.String         `tfsdk:"name"`
+	Password           types.String         `tfsdk:"password"`
+	PasswordAlgorithm  types.String         `tfsdk:"password_algorithm"`
+	PasswordDigest     types.String         `tfsdk:"password_digest"`
+	PasswordVersion    types.Int64          `tfsdk:"password_version"`
+	PrimaryEmail       types.String         `tfsdk:"primary_email"`
+	PrimaryPhone       types.String         `tfsdk:"primary_phone"`
+	Profile            ProfileValue         `tfsdk:"profile"`
+	RoleIds            types.Set            `tfsdk:"role_ids"`
+	UpdatedAt          types.Number         `tfsdk:"updated_at"`
+	Username           types.String         `tfsdk:"username"`
+}
+
+var _ basetypes.ObjectTypable = ProfileType{}
+
+type ProfileType struct {
+	basetypes.ObjectType
+}
+
+func (t ProfileType) Equal(o attr.Type) bool {
+	other, ok := o.(ProfileType)
+
+	if !ok {
+		return false
+	}
+
+	return t.ObjectType.Equal(other.ObjectType)
+}
+
+func (t ProfileType) String() string {
+	return "ProfileType"
+}
+
+func (t ProfileType) ValueFromObject(ctx context.Context, in basetypes.ObjectValue) (basetypes.ObjectValuable, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	attributes := in.Attributes()
+
+	addressAttribute, ok := attributes["address"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`address is missing from object`)
+
+		return nil, diags
+	}
+
+	addressVal, ok := addressAttribute.(basetypes.ObjectValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`address expected to be basetypes.ObjectValue, was: %T`, addressAttribute))
+	}
+
+	birthdateAttribute, ok := attributes["birthdate"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`birthdate is missing from object`)
+
+		return nil, diags
+	}
+
+	birthdateVal, ok := birthdateAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`birthdate expected to be basetypes.StringValue, was: %T`, birthdateAttribute))
+	}
+
+	familyNameAttribute, ok := attributes["family_name"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`family_name is missing from object`)
+
+		return nil, diags
+	}
+
+	familyNameVal, ok := familyNameAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`family_name expected to be basetypes.StringValue, was: %T`, familyNameAttribute))
+	}
+
+	genderAttribute, ok := attributes["gender"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`gender is missing from object`)
+
+		return nil, diags
+	}
+
+	genderVal, ok := genderAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`gender expected to be basetypes.StringValue, was: %T`, genderAttribute))
+	}
+
+	givenNameAttribute, ok := attributes["given_name"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`given_name is missing from object`)
+
+		return nil, diags
+	}
+
+	givenNameVal, ok := givenNameAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`given_name expected to be basetypes.StringValue, was: %T`, givenNameAttribute))
+	}
+
+	localeAttribute, ok := attributes["locale"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`locale is missing from object`)
+
+		return nil, diags
+	}
+
+	localeVal, ok := localeAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`locale expected to be basetypes.StringValue, was: %T`, localeAttribute))
+	}
+
+	middleNameAttribute, ok := attributes["middle_name"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`middle_name is missing from object`)
+
+		return nil, diags
+	}
+
+	middleNameVal, ok := middleNameAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`middle_name expected to be basetypes.StringValue, was: %T`, middleNameAttribute))
+	}
+
+	nicknameAttribute, ok := attributes["nickname"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`nickname is missing from object`)
+
+		return nil, diags
+	}
+
+	nicknameVal, ok := nicknameAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`nickname expected to be basetypes.StringValue, was: %T`, nicknameAttribute))
+	}
+
+	preferredUsernameAttribute, ok := attributes["preferred_username"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`preferred_username is missing from object`)
+
+		return nil, diags
+	}
+
+	preferredUsernameVal, ok := preferredUsernameAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`preferred_username expected to be basetypes.StringValue, was: %T`, preferredUsernameAttribute))
+	}
+
+	profileAttribute, ok := attributes["profile"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`profile is missing from object`)
+
+		return nil, diags
+	}
+
+	profileVal, ok := profileAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`profile expected to be basetypes.StringValue, was: %T`, profileAttribute))
+	}
+
+	websiteAttribute, ok := attributes["website"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`website is missing from object`)
+
+		return nil, diags
+	}
+
+	websiteVal, ok := websiteAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`website expected to be basetypes.StringValue, was: %T`, websiteAttribute))
+	}
+
+	zoneinfoAttribute, ok := attributes["zoneinfo"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`zoneinfo is missing from object`)
+
+		return nil, diags
+	}
+
+	zoneinfoVal, ok := zoneinfoAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`zoneinfo expected to be basetypes.StringValue, was: %T`, zoneinfoAttribute))
+	}
+
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	return ProfileValue{
+		Address:           addressVal,
+		Birthdate:         birthdateVal,
+		FamilyName:        familyNameVal,
+		Gender:            genderVal,
+		GivenName:         givenNameVal,
+		Locale:            localeVal,
+		MiddleName:        middleNameVal,
+		Nickname:          nicknameVal,
+		PreferredUsername: preferredUsernameVal,
+		Profile:           profileVal,
+		Website:           websiteVal,
+		Zoneinfo:          zoneinfoVal,
+		state:             attr.ValueStateKnown,
+	}, diags
+}
+
+func NewProfileValueNull() ProfileValue {
+	return ProfileValue{
+		state: attr.ValueStateNull,
+	}
+}
+
+func NewProfileValueUnknown() ProfileValue {
+	return ProfileValue{
+		state: attr.ValueStateUnknown,
+	}
+}
+
+func NewProfileValue(attributeTypes map[string]attr.Type, attributes map[string]attr.Value) (ProfileValue, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	// Reference: https://github.com/hashicorp/terraform-plugin-framework/issues/521
+	ctx := context.Background()
+
+	for name, attributeType := range attributeTypes {
+		attribute, ok := attributes[name]
+
+		if !ok {
+			diags.AddError(
+				"Missing ProfileValue Attribute Value",
+				"While creating a ProfileValue value, a missing attribute value was detected. "+
+					"A ProfileValue must contain values for all attributes, even if null or unknown. "+
+					"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+					fmt.Sprintf("ProfileValue Attribute Name (%s) Expected Type: %s", name, attributeType.String()),
+			)
+
+			continue
+		}
+
+		if !attributeType.Equal(attribute.Type(ctx)) {
+			diags.AddError(
+				"Invalid ProfileValue Attribute Type",
+				"While creating a ProfileValue value, an invalid attribute value was detected. "+
+					"A ProfileValue must use a matching attribute type for the value. "+
+					"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+					fmt.Sprintf("ProfileValue Attribute Name (%s) Expected Type: %s\n", name, attributeType.String())+
+					fmt.Sprintf("ProfileValue Attribute Name (%s) Given Type: %s", name, attribute.Type(ctx)),
+			)
+		}
+	}
+
+	for name := range attributes {
+		_, ok := attributeTypes[name]
+
+		if !ok {
+			diags.AddError(
+				"Extra ProfileValue Attribute Value",
+				"While creating a ProfileValue value, an extra attribute value was detected. "+
+					"A ProfileValue must not contain values beyond the expected attribute types. "+
+					"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+					fmt.Sprintf("Extra ProfileValue Attribute Name: %s", name),
+			)
+		}
+	}
+
+	if diags.HasError() {
+		return NewProfileValueUnknown(), diags
+	}
+
+	addressAttribute, ok := attributes["address"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`address is missing from object`)
+
+		return NewProfileValueUnknown(), diags
+	}
+
+	addressVal, ok := addressAttribute.(basetypes.ObjectValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`address expected to be basetypes.ObjectValue, was: %T`, addressAttribute))
+	}
+
+	birthdateAttribute, ok := attributes["birthdate"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`birthdate is missing from object`)
+
+		return NewProfileValueUnknown(), diags
+	}
+
+	birthdateVal, ok := birthdateAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`birthdate expected to be basetypes.StringValue, was: %T`, birthdateAttribute))
+	}
+
+	familyNameAttribute, ok := attributes["family_name"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`family_name is missing from object`)
+
+		return NewProfileValueUnknown(), diags
+	}
+
+	familyNameVal, ok := familyNameAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`family_name expected to be basetypes.StringValue, was: %T`, familyNameAttribute))
+	}
+
+	genderAttribute, ok := attributes["gender"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`gender is missing from object`)
+
+		return NewProfileValueUnknown(), diags
+	}
+
+	genderVal, ok := genderAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`gender expected to be basetypes.StringValue, was: %T`, genderAttribute))
+	}
+
+	givenNameAttribute, ok := attributes["given_name"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`given_name is missing from object`)
+
+		return NewProfileValueUnknown(), diags
+	}
+
+	givenNameVal, ok := givenNameAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`given_name expected to be basetypes.StringValue, was: %T`, givenNameAttribute))
+	}
+
+	localeAttribute, ok := attributes["locale"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`locale is missing from object`)
+
+		return NewProfileValueUnknown(), diags
+	}
+
+	localeVal, ok := localeAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`locale expected to be basetypes.StringValue, was: %T`, localeAttribute))
+	}
+
+	middleNameAttribute, ok := attributes["middle_name"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`middle_name is missing from object`)
+
+		return NewProfileValueUnknown(), diags
+	}
+
+	middleNameVal, ok := middleNameAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`middle_name expected to be basetypes.StringValue, was: %T`, middleNameAttribute))
+	}
+
+	nicknameAttribute, ok := attributes["nickname"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`nickname is missing from object`)
+
+		return NewProfileValueUnknown(), diags
+	}
+
+	nicknameVal, ok := nicknameAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`nickname expected to be basetypes.StringValue, was: %T`, nicknameAttribute))
+	}
+
+	preferredUsernameAttribute, ok := attributes["preferred_username"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`preferred_username is missing from object`)
+
+		return NewProfileValueUnknown(), diags
+	}
+
+	preferredUsernameVal, ok := preferredUsernameAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`preferred_username expected to be basetypes.StringValue, was: %T`, preferredUsernameAttribute))
+	}
+
+	profileAttribute, ok := attributes["profile"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`profile is missing from object`)
+
+		return NewProfileValueUnknown(), diags
+	}
+
+	profileVal, ok := profileAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`profile expected to be basetypes.StringValue, was: %T`, profileAttribute))
+	}
+
+	websiteAttribute, ok := attributes["website"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`website is missing from object`)
+
+		return NewProfileValueUnknown(), diags
+	}
+
+	websiteVal, ok := websiteAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`website expected to be basetypes.StringValue, was: %T`, websiteAttribute))
+	}
+
+	zoneinfoAttribute, ok := attributes["zoneinfo"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`zoneinfo is missing from object`)
+
+		return NewProfileValueUnknown(), diags
+	}
+
+	zoneinfoVal, ok := zoneinfoAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`zoneinfo expected to be basetypes.StringValue, was: %T`, zoneinfoAttribute))
+	}
+
+	if diags.HasError() {
+		return NewProfileValueUnknown(), diags
+	}
+
+	return ProfileValue{
+		Address:           addressVal,
+		Birthdate:         birthdateVal,
+		FamilyName:        familyNameVal,
+		Gender:            genderVal,
+		GivenName:         givenNameVal,
+		Locale:            localeVal,
+		MiddleName:        middleNameVal,
+		Nickname:          nicknameVal,
+		PreferredUsername: preferredUsernameVal,
+		Profile:           profileVal,
+		Website:           websiteVal,
+		Zoneinfo:          zoneinfoVal,
+		state:             attr.ValueStateKnown,
+	}, diags
+}
+
+func NewProfileValueMust(attributeTypes map[string]attr.Type, attributes map[string]attr.Value) ProfileValue {
+	object, diags := NewProfileValue(attributeTypes, attributes)
+
+	if diags.HasError() {
+		// This could potentially be added to the diag package.
+		diagsStrings := make([]string, 0, len(diags))
+
+		for _, diagnostic := range diags {
+			diagsStrings = append(diagsStrings, fmt.Sprintf(
+				"%s | %s | %s",
+				diagnostic.Severity(),
+				diagnostic.Summary(),
+				diagnostic.Detail()))
+		}
+
+		panic("NewProfileValueMust received error(s): " + strings.Join(diagsStrings, "\n"))
+	}
+
+	return object
+}
+
+func (t ProfileType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	if in.Type() == nil {
+		return NewProfileValueNull(), nil
+	}
+
+	if !in.Type().Equal(t.TerraformType(ctx)) {
+		return nil, fmt.Errorf("expected %s, got %s", t.TerraformType(ctx), in.Type())
+	}
+
+	if !in.IsKnown() {
+		return NewProfileValueUnknown(), nil
+	}
+
+	if in.IsNull() {
+		return NewProfileValueNull(), nil
+	}
+
+	attributes := map[string]attr.Value{}
+
+	val := map[string]tftypes.Value{}
+
+	err := in.As(&val)
+
+	if err != nil {
+		return nil, err
+	}
+
+	for k, v := range val {
+		a, err := t.AttrTypes[k].ValueFromTerraform(ctx, v)
+
+		if err != nil {
+			return nil, err
+		}
+
+		attributes[k] = a
+	}
+
+	return NewProfileValueMust(ProfileValue{}.AttributeTypes(ctx), attributes), nil
+}
+
+func (t ProfileType) ValueType(ctx context.Context) attr.Value {
+	return ProfileValue{}
+}
+
+var _ basetypes.ObjectValuable = ProfileValue{}
+
+type ProfileValue struct {
+	Address           basetypes.ObjectValue `tfsdk:"address"`
+	Birthdate         basetypes.StringValue `tfsdk:"birthdate"`
+	FamilyName        basetypes.StringValue `tfsdk:"family_name"`
+	Gender            basetypes.StringValue `tfsdk:"gender"`
+	GivenName         basetypes.StringValue `tfsdk:"given_name"`
+	Locale            basetypes.StringValue `tfsdk:"locale"`
+	MiddleName        basetypes.StringValue `tfsdk:"middle_name"`
+	Nickname          basetypes.StringValue `tfsdk:"nickname"`
+	PreferredUsername basetypes.StringValue `tfsdk:"preferred_username"`
+	Profile           basetypes.StringValue `tfsdk:"profile"`
+	Website           basetypes.StringValue `tfsdk:"website"`
+	Zoneinfo          basetypes.StringValue `tfsdk:"zoneinfo"`
+	state             attr.ValueState
+}
+
+func (v ProfileValue) ToTerraformValue(ctx context.Context) (tftypes.Value, error) {
+	attrTypes := make(map[string]tftypes.Type, 12)
+
+	var val tftypes.Value
+	var err error
+
+	attrTypes["address"] = basetypes.ObjectType{
+		AttrTypes: AddressValue{}.AttributeTypes(ctx),
+	}.TerraformType(ctx)
+	attrTypes["birthdate"] = basetypes.StringType{}.TerraformType(ctx)
+	attrTypes["family_name"] = basetypes.StringType{}.TerraformType(ctx)
+	attrTypes["gender"] = basetypes.StringType{}.TerraformType(ctx)
+	attrTypes["given_name"] = basetypes.StringType{}.TerraformType(ctx)
+	attrTypes["locale"] = basetypes.StringType{}.TerraformType(ctx)
+	attrTypes["middle_name"] = basetypes.StringType{}.TerraformType(ctx)
+	attrTypes["nickname"] = basetypes.StringType{}.TerraformType(ctx)
+	attrTypes["preferred_username"] = basetypes.StringType{}.TerraformType(ctx)
+	attrTypes["profile"] = basetypes.StringType{}.TerraformType(ctx)
+	attrTypes["website"] = basetypes.StringType{}.TerraformType(ctx)
+	attrTypes["zoneinfo"] = basetypes.StringType{}.TerraformType(ctx)
+
+	objectType := tftypes.Object{AttributeTypes: attrTypes}
+
+	switch v.state {
+	case attr.ValueStateKnown:
+		vals := make(map[string]tftypes.Value, 12)
+
+		val, err = v.Address.ToTerraformValue(ctx)
+
+		if err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		vals["address"] = val
+
+		val, err = v.Birthdate.ToTerraformValue(ctx)
+
+		if err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		vals["birthdate"] = val
+
+		val, err = v.FamilyName.ToTerraformValue(ctx)
+
+		if err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		vals["family_name"] = val
+
+		val, err = v.Gender.ToTerraformValue(ctx)
+
+		if err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		vals["gender"] = val
+
+		val, err = v.GivenName.ToTerraformValue(ctx)
+
+		if err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		vals["given_name"] = val
+
+		val, err = v.Locale.ToTerraformValue(ctx)
+
+		if err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		vals["locale"] = val
+
+		val, err = v.MiddleName.ToTerraformValue(ctx)
+
+		if err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		vals["middle_name"] = val
+
+		val, err = v.Nickname.ToTerraformValue(ctx)
+
+		if err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		vals["nickname"] = val
+
+		val, err = v.PreferredUsername.ToTerraformValue(ctx)
+
+		if err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		vals["preferred_username"] = val
+
+		val, err = v.Profile.ToTerraformValue(ctx)
+
+		if err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		vals["profile"] = val
+
+		val, err = v.Website.ToTerraformValue(ctx)
+
+		if err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		vals["website"] = val
+
+		val, err = v.Zoneinfo.ToTerraformValue(ctx)
+
+		if err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		vals["zoneinfo"] = val
+
+		if err := tftypes.ValidateValue(objectType, vals); err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		return tftypes.NewValue(objectType, vals), nil
+	case attr.ValueStateNull:
+		return tftypes.NewValue(objectType, nil), nil
+	case attr.ValueStateUnknown:
+		return tftypes.NewValue(objectType, tftypes.UnknownValue), nil
+	default:
+		panic(fmt.Sprintf("unhandled Object state in ToTerraformValue: %s", v.state))
+	}
+}
+
+func (v ProfileValue) IsNull() bool {
+	return v.state == attr.ValueStateNull
+}
+
+func (v ProfileValue) IsUnknown() bool {
+	return v.state == attr.ValueStateUnknown
+}
+
+func (v ProfileValue) String() string {
+	return "ProfileValue"
+}
+
+func (v ProfileValue) ToObjectValue(ctx context.Context) (basetypes.ObjectValue, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	var address basetypes.ObjectValue
+
+	if v.Address.IsNull() {
+		address = types.ObjectNull(
+			AddressValue{}.AttributeTypes(ctx),
+		)
+	}
+
+	if v.Address.IsUnknown() {
+		address = types.ObjectUnknown(
+			AddressValue{}.AttributeTypes(ctx),
+		)
+	}
+
+	if !v.Address.IsNull() && !v.Address.IsUnknown() {
+		address = types.ObjectValueMust(
+			AddressValue{}.AttributeTypes(ctx),
+			v.Address.Attributes(),
+		)
+	}
+
+	attributeTypes := map[string]attr.Type{
+		"address": basetypes.ObjectType{
+			AttrTypes: AddressValue{}.AttributeTypes(ctx),
+		},
+		"birthdate":          basetypes.StringType{},
+		"family_name":        basetypes.StringType{},
+		"gender":             basetypes.StringType{},
+		"given_name":         basetypes.StringType{},
+		"locale":             basetypes.StringType{},
+		"middle_name":        basetypes.StringType{},
+		"nickname":           basetypes.StringType{},
+		"preferred_username": basetypes.StringType{},
+		"profile":            basetypes.StringType{},
+		"website":            basetypes.StringType{},
+		"zoneinfo":           basetypes.StringType{},
+	}
+
+	if v.IsNull() {
+		return types.ObjectNull(attributeTypes), diags
+	}
+
+	if v.IsUnknown() {
+		return types.ObjectUnknown(attributeTypes), diags
+	}
+
+	objVal, diags := types.ObjectValue(
+		attributeTypes,
+		map[string]attr.Value{
+			"address":            address,
+			"birthdate":          v.Birthdate,
+			"family_name":        v.FamilyName,
+			"gender":             v.Gender,
+			"given_name":         v.GivenName,
+			"locale":             v.Locale,
+			"middle_name":        v.MiddleName,
+			"nickname":           v.Nickname,
+			"preferred_username": v.PreferredUsername,
+			"profile":            v.Profile,
+			"website":            v.Website,
+			"zoneinfo":           v.Zoneinfo,
+		})
+
+	return objVal, diags
+}
+
+func (v ProfileValue) Equal(o attr.Value) bool {
+	other, ok := o.(ProfileValue)
+
+	if !ok {
+		return false
+	}
+
+	if v.state != other.state {
+		return false
+	}
+
+	if v.state != attr.ValueStateKnown {
+		return true
+	}
+
+	if !v.Address.Equal(other.Address) {
+		return false
+	}
+
+	if !v.Birthdate.Equal(other.Birthdate) {
+		return false
+	}
+
+	if !v.FamilyName.Equal(other.FamilyName) {
+		return false
+	}
+
+	if !v.Gender.Equal(other.Gender) {
+		return false
+	}
+
+	if !v.GivenName.Equal(other.GivenName) {
+		return false
+	}
+
+	if !v.Locale.Equal(other.Locale) {
+		return false
+	}
+
+	if !v.MiddleName.Equal(other.MiddleName) {
+		return false
+	}
+
+	if !v.Nickname.Equal(other.Nickname) {
+		return false
+	}
+
+	if !v.PreferredUsername.Equal(other.PreferredUsername) {
+		return false
+	}
+
+	if !v.Profile.Equal(other.Profile) {
+		return false
+	}
+
+	if !v.Website.Equal(other.Website) {
+		return false
+	}
+
+	if !v.Zoneinfo.Equal(other.Zoneinfo) {
+		return false
+	}
+
+	return true
+}
+
+func (v ProfileValue) Type(ctx context.Context) attr.Type {
+	return ProfileType{
+		basetypes.ObjectType{
+			AttrTypes: v.AttributeTypes(ctx),
+		},
+	}
+}
+
+func (v ProfileValue) AttributeTypes(ctx context.Context) map[string]attr.Type {
+	return map[string]attr.Type{
+		"address": basetypes.ObjectType{
+			AttrTypes: AddressValue{}.AttributeTypes(ctx),
+		},
+		"birthdate":          basetypes.StringType{},
+		"family_name":        basetypes.StringType{},
+		"gender":             basetypes.StringType{},
+		"given_name":         basetypes.StringType{},
+		"locale":             basetypes.StringType{},
+		"middle_name":        basetypes.StringType{},
+		"nickname":           basetypes.StringType{},
+		"preferred_username": basetypes.StringType{},
+		"profile":            basetypes.StringType{},
+		"website":            basetypes.StringType{},
+		"zoneinfo":           basetypes.StringType{},
+	}
 }
 
-var _ basetypes.ObjectTypable = ProfileType{}
+var _ basetypes.ObjectTypable = AddressType{}
 
-type ProfileType struct {
+type AddressType struct {
 	basetypes.ObjectType
 }
 
-func (t ProfileType) Equal(o attr.Type) bool {
-	other, ok := o.(ProfileType)
+func (t AddressType) Equal(o attr.Type) bool {
+	other, ok := o.(AddressType)
 
 	if !ok {
 		return false
@@ -113,113 +1301,151 @@ func (t ProfileType) Equal(o attr.Type) bool {
 	return t.ObjectType.Equal(other.ObjectType)
 }
 
-func (t ProfileType) String() string {
-	return "ProfileType"
+func (t AddressType) String() string {
+	return "AddressType"
 }
 
-func (t ProfileType) ValueFromObject(ctx context.Context, in basetypes.ObjectValue) (basetypes.ObjectValuable, diag.Diagnostics) {
+func (t AddressType) ValueFromObject(ctx context.Context, in basetypes.ObjectValue) (basetypes.ObjectValuable, diag.Diagnostics) {
 	var diags diag.Diagnostics
 
 	attributes := in.Attributes()
 
-	familyNameAttribute, ok := attributes["family_name"]
+	countryAttribute, ok := attributes["country"]
 
 	if !ok {
 		diags.AddError(
 			"Attribute Missing",
-			`family_name is missing from object`)
+			`country is missing from object`)
 
 		return nil, diags
 	}
 
-	familyNameVal, ok := familyNameAttribute.(basetypes.StringValue)
+	countryVal, ok := countryAttribute.(basetypes.StringValue)
 
 	if !ok {
 		diags.AddError(
 			"Attribute Wrong Type",
-			fmt.Sprintf(`family_name expected to be basetypes.StringValue, was: %T`, familyNameAttribute))
+			fmt.Sprintf(`country expected to be basetypes.StringValue, was: %T`, countryAttribute))
 	}
 
-	givenNameAttribute, ok := attributes["given_name"]
+	formattedAttribute, ok := attributes["formatted"]
 
 	if !ok {
 		diags.AddError(
 			"Attribute Missing",
-			`given_name is missing from object`)
+			`formatted is missing from object`)
 
 		return nil, diags
 	}
 
-	givenNameVal, ok := givenNameAttribute.(basetypes.StringValue)
+	formattedVal, ok := formattedAttribute.(basetypes.StringValue)
 
 	if !ok {
 		diags.AddError(
 			"Attribute Wrong Type",
-			fmt.Sprintf(`given_name expected to be basetypes.StringValue, was: %T`, givenNameAttribute))
+			fmt.Sprintf(`formatted expected to be basetypes.StringValue, was: %T`, formattedAttribute))
 	}
 
-	middleNameAttribute, ok := attributes["middle_name"]
+	localityAttribute, ok := attributes["locality"]
 
 	if !ok {
 		diags.AddError(
 			"Attribute Missing",
-			`middle_name is missing from object`)
+			`locality is missing from object`)
 
 		return nil, diags
 	}
 
-	middleNameVal, ok := middleNameAttribute.(basetypes.StringValue)
+	localityVal, ok := localityAttribute.(basetypes.StringValue)
 
 	if !ok {
 		diags.AddError(
 			"Attribute Wrong Type",
-			fmt.Sprintf(`middle_name expected to be basetypes.StringValue, was: %T`, middleNameAttribute))
+			fmt.Sprintf(`locality expected to be basetypes.StringValue, was: %T`, localityAttribute))
 	}
 
-	nicknameAttribute, ok := attributes["nickname"]
+	postalCodeAttribute, ok := attributes["postal_code"]
 
 	if !ok {
 		diags.AddError(
 			"Attribute Missing",
-			`nickname is missing from object`)
+			`postal_code is missing from object`)
 
 		return nil, diags
 	}
 
-	nicknameVal, ok := nicknameAttribute.(basetypes.StringValue)
+	postalCodeVal, ok := postalCodeAttribute.(basetypes.StringValue)
 
 	if !ok {
 		diags.AddError(
 			"Attribute Wrong Type",
-			fmt.Sprintf(`nickname expected to be basetypes.StringValue, was: %T`, nicknameAttribute))
+			fmt.Sprintf(`postal_code expected to be basetypes.StringValue, was: %T`, postalCodeAttribute))
+	}
+
+	regionAttribute, ok := attributes["region"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`region is missing from object`)
+
+		return nil, diags
+	}
+
+	regionVal, ok := regionAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`region expected to be basetypes.StringValue, was: %T`, regionAttribute))
+	}
+
+	streetAddressAttribute, ok := attributes["street_address"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`street_address is missing from object`)
+
+		return nil, diags
+	}
+
+	streetAddressVal, ok := streetAddressAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`street_address expected to be basetypes.StringValue, was: %T`, streetAddressAttribute))
 	}
 
 	if diags.HasError() {
 		return nil, diags
 	}
 
-	return ProfileValue{
-		FamilyName: familyNameVal,
-		GivenName:  givenNameVal,
-		MiddleName: middleNameVal,
-		Nickname:   nicknameVal,
-		state:      attr.ValueStateKnown,
+	return AddressValue{
+		Country:       countryVal,
+		Formatted:     formattedVal,
+		Locality:      localityVal,
+		PostalCode:    postalCodeVal,
+		Region:        regionVal,
+		StreetAddress: streetAddressVal,
+		state:         attr.ValueStateKnown,
 	}, diags
 }
 
-func NewProfileValueNull() ProfileValue {
-	return ProfileValue{
+func NewAddressValueNull() AddressValue {
+	return AddressValue{
 		state: attr.ValueStateNull,
 	}
 }
 
-func NewProfileValueUnknown() ProfileValue {
-	return ProfileValue{
+func NewAddressValueUnknown() AddressValue {
+	return AddressValue{
 		state: attr.ValueStateUnknown,
 	}
 }
 
-func NewProfileValue(attributeTypes map[string]attr.Type, attributes map[string]attr.Value) (ProfileValue, diag.Diagnostics) {
+func NewAddressValue(attributeTypes map[string]attr.Type, attributes map[string]attr.Value) (AddressValue, diag.Diagnostics) {
 	var diags diag.Diagnostics
 
 	// Reference: https://github.com/hashicorp/terraform-plugin-framework/issues/521
@@ -230,11 +1456,11 @@ func NewProfileValue(attributeTypes map[string]attr.Type, attributes map[string]
 
 		if !ok {
 			diags.AddError(
-				"Missing ProfileValue Attribute Value",
-				"While creating a ProfileValue value, a missing attribute value was detected. "+
-					"A ProfileValue must contain values for all attributes, even if null or unknown. "+
+				"Missing AddressValue Attribute Value",
+				"While creating a AddressValue value, a missing attribute value was detected. "+
+					"A AddressValue must contain values for all attributes, even if null or unknown. "+
 					"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
-					fmt.Sprintf("ProfileValue Attribute Name (%s) Expected Type: %s", name, attributeType.String()),
+					fmt.Sprintf("AddressValue Attribute Name (%s) Expected Type: %s", name, attributeType.String()),
 			)
 
 			continue
@@ -242,12 +1468,12 @@ func NewProfileValue(attributeTypes map[string]attr.Type, attributes map[string]
 
 		if !attributeType.Equal(attribute.Type(ctx)) {
 			diags.AddError(
-				"Invalid ProfileValue Attribute Type",
-				"While creating a ProfileValue value, an invalid attribute value was detected. "+
-					"A ProfileValue must use a matching attribute type for the value. "+
+				"Invalid AddressValue Attribute Type",
+				"While creating a AddressValue value, an invalid attribute value was detected. "+
+					"A AddressValue must use a matching attribute type for the value. "+
 					"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
-					fmt.Sprintf("ProfileValue Attribute Name (%s) Expected Type: %s\n", name, attributeType.String())+
-					fmt.Sprintf("ProfileValue Attribute Name (%s) Given Type: %s", name, attribute.Type(ctx)),
+					fmt.Sprintf("AddressValue Attribute Name (%s) Expected Type: %s\n", name, attributeType.String())+
+					fmt.Sprintf("AddressValue Attribute Name (%s) Given Type: %s", name, attribute.Type(ctx)),
 			)
 		}
 	}
@@ -257,106 +1483,144 @@ func NewProfileValue(attributeTypes map[string]attr.Type, attributes map[string]
 
 		if !ok {
 			diags.AddError(
-				"Extra ProfileValue Attribute Value",
-				"While creating a ProfileValue value, an extra attribute value was detected. "+
-					"A ProfileValue must not contain values beyond the expected attribute types. "+
+				"Extra AddressValue Attribute Value",
+				"While creating a AddressValue value, an extra attribute value was detected. "+
+					"A AddressValue must not contain values beyond the expected attribute types. "+
 					"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
-					fmt.Sprintf("Extra ProfileValue Attribute Name: %s", name),
+					fmt.Sprintf("Extra AddressValue Attribute Name: %s", name),
 			)
 		}
 	}
 
 	if diags.HasError() {
-		return NewProfileValueUnknown(), diags
+		return NewAddressValueUnknown(), diags
 	}
 
-	familyNameAttribute, ok := attributes["family_name"]
+	countryAttribute, ok := attributes["country"]
 
 	if !ok {
 		diags.AddError(
 			"Attribute Missing",
-			`family_name is missing from object`)
+			`country is missing from object`)
 
-		return NewProfileValueUnknown(), diags
+		return NewAddressValueUnknown(), diags
 	}
 
-	familyNameVal, ok := familyNameAttribute.(basetypes.StringValue)
+	countryVal, ok := countryAttribute.(basetypes.StringValue)
 
 	if !ok {
 		diags.AddError(
 			"Attribute Wrong Type",
-			fmt.Sprintf(`family_name expected to be basetypes.StringValue, was: %T`, familyNameAttribute))
+			fmt.Sprintf(`country expected to be basetypes.StringValue, was: %T`, countryAttribute))
 	}
 
-	givenNameAttribute, ok := attributes["given_name"]
+	formattedAttribute, ok := attributes["formatted"]
 
 	if !ok {
 		diags.AddError(
 			"Attribute Missing",
-			`given_name is missing from object`)
+			`formatted is missing from object`)
 
-		return NewProfileValueUnknown(), diags
+		return NewAddressValueUnknown(), diags
 	}
 
-	givenNameVal, ok := givenNameAttribute.(basetypes.StringValue)
+	formattedVal, ok := formattedAttribute.(basetypes.StringValue)
 
 	if !ok {
 		diags.AddError(
 			"Attribute Wrong Type",
-			fmt.Sprintf(`given_name expected to be basetypes.StringValue, was: %T`, givenNameAttribute))
+			fmt.Sprintf(`formatted expected to be basetypes.StringValue, was: %T`, formattedAttribute))
 	}
 
-	middleNameAttribute, ok := attributes["middle_name"]
+	localityAttribute, ok := attributes["locality"]
 
 	if !ok {
 		diags.AddError(
 			"Attribute Missing",
-			`middle_name is missing from object`)
+			`locality is missing from object`)
 
-		return NewProfileValueUnknown(), diags
+		return NewAddressValueUnknown(), diags
 	}
 
-	middleNameVal, ok := middleNameAttribute.(basetypes.StringValue)
+	localityVal, ok := localityAttribute.(basetypes.StringValue)
 
 	if !ok {
 		diags.AddError(
 			"Attribute Wrong Type",
-			fmt.Sprintf(`middle_name expected to be basetypes.StringValue, was: %T`, middleNameAttribute))
+			fmt.Sprintf(`locality expected to be basetypes.StringValue, was: %T`, localityAttribute))
 	}
 
-	nicknameAttribute, ok := attributes["nickname"]
+	postalCodeAttribute, ok := attributes["postal_code"]
 
 	if !ok {
 		diags.AddError(
 			"Attribute Missing",
-			`nickname is missing from object`)
+			`postal_code is missing from object`)
 
-		return NewProfileValueUnknown(), diags
+		return NewAddressValueUnknown(), diags
 	}
 
-	nicknameVal, ok := nicknameAttribute.(basetypes.StringValue)
+	postalCodeVal, ok := postalCodeAttribute.(basetypes.StringValue)
 
 	if !ok {
 		diags.AddError(
 			"Attribute Wrong Type",
-			fmt.Sprintf(`nickname expected to be basetypes.StringValue, was: %T`, nicknameAttribute))
+			fmt.Sprintf(`postal_code expected to be basetypes.StringValue, was: %T`, postalCodeAttribute))
+	}
+
+	regionAttribute, ok := attributes["region"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`region is missing from object`)
+
+		return NewAddressValueUnknown(), diags
+	}
+
+	regionVal, ok := regionAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`region expected to be basetypes.StringValue, was: %T`, regionAttribute))
+	}
+
+	streetAddressAttribute, ok := attributes["street_address"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`street_address is missing from object`)
+
+		return NewAddressValueUnknown(), diags
+	}
+
+	streetAddressVal, ok := streetAddressAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`street_address expected to be basetypes.StringValue, was: %T`, streetAddressAttribute))
 	}
 
 	if diags.HasError() {
-		return NewProfileValueUnknown(), diags
+		return NewAddressValueUnknown(), diags
 	}
 
-	return ProfileValue{
-		FamilyName: familyNameVal,
-		GivenName:  givenNameVal,
-		MiddleName: middleNameVal,
-		Nickname:   nicknameVal,
-		state:      attr.ValueStateKnown,
+	return AddressValue{
+		Country:       countryVal,
+		Formatted:     formattedVal,
+		Locality:      localityVal,
+		PostalCode:    postalCodeVal,
+		Region:        regionVal,
+		StreetAddress: streetAddressVal,
+		state:         attr.ValueStateKnown,
 	}, diags
 }
 
-func NewProfileValueMust(attributeTypes map[string]attr.Type, attributes map[string]attr.Value) ProfileValue {
-	object, diags := NewProfileValue(attributeTypes, attributes)
+func NewAddressValueMust(attributeTypes map[string]attr.Type, attributes map[string]attr.Value) AddressValue {
+	object, diags := NewAddressValue(attributeTypes, attributes)
 
 	if diags.HasError() {
 		// This could potentially be added to the diag package.
@@ -370,15 +1634,15 @@ func NewProfileValueMust(attributeTypes map[string]attr.Type, attributes map[str
 				diagnostic.Detail()))
 		}
 
-		panic("NewProfileValueMust received error(s): " + strings.Join(diagsStrings, "\n"))
+		panic("NewAddressValueMust received error(s): " + strings.Join(diagsStrings, "\n"))
 	}
 
 	return object
 }
 
-func (t ProfileType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+func (t AddressType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
 	if in.Type() == nil {
-		return NewProfileValueNull(), nil
+		return NewAddressValueNull(), nil
 	}
 
 	if !in.Type().Equal(t.TerraformType(ctx)) {
@@ -386,11 +1650,11 @@ func (t ProfileType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (
 	}
 
 	if !in.IsKnown() {
-		return NewProfileValueUnknown(), nil
+		return NewAddressValueUnknown(), nil
 	}
 
 	if in.IsNull() {
-		return NewProfileValueNull(), nil
+		return NewAddressValueNull(), nil
 	}
 
 	attributes := map[string]attr.Value{}
@@ -413,71 +1677,91 @@ func (t ProfileType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (
 		attributes[k] = a
 	}
 
-	return NewProfileValueMust(ProfileValue{}.AttributeTypes(ctx), attributes), nil
+	return NewAddressValueMust(AddressValue{}.AttributeTypes(ctx), attributes), nil
 }
 
-func (t ProfileType) ValueType(ctx context.Context) attr.Value {
-	return ProfileValue{}
+func (t AddressType) ValueType(ctx context.Context) attr.Value {
+	return AddressValue{}
 }
 
-var _ basetypes.ObjectValuable = ProfileValue{}
+var _ basetypes.ObjectValuable = AddressValue{}
 
-type ProfileValue struct {
-	FamilyName basetypes.StringValue `tfsdk:"family_name"`
-	GivenName  basetypes.StringValue `tfsdk:"given_name"`
-	MiddleName basetypes.StringValue `tfsdk:"middle_name"`
-	Nickname   basetypes.StringValue `tfsdk:"nickname"`
-	state      attr.ValueState
+type AddressValue struct {
+	Country       basetypes.StringValue `tfsdk:"country"`
+	Formatted     basetypes.StringValue `tfsdk:"formatted"`
+	Locality      basetypes.StringValue `tfsdk:"locality"`
+	PostalCode    basetypes.StringValue `tfsdk:"postal_code"`
+	Region        basetypes.StringValue `tfsdk:"region"`
+	StreetAddress basetypes.StringValue `tfsdk:"street_address"`
+	state         attr.ValueState
 }
 
-func (v ProfileValue) ToTerraformValue(ctx context.Context) (tftypes.Value, error) {
-	attrTypes := make(map[string]tftypes.Type, 4)
+func (v AddressValue) ToTerraformValue(ctx context.Context) (tftypes.Value, error) {
+	attrTypes := make(map[string]tftypes.Type, 6)
 
 	var val tftypes.Value
 	var err error
 
-	attrTypes["family_name"] = basetypes.StringType{}.TerraformType(ctx)
-	attrTypes["given_name"] = basetypes.StringType{}.TerraformType(ctx)
-	attrTypes["middle_name"] = basetypes.StringType{}.TerraformType(ctx)
-	attrTypes["nickname"] = basetypes.StringType{}.TerraformType(ctx)
+	attrTypes["country"] = basetypes.StringType{}.TerraformType(ctx)
+	attrTypes["formatted"] = basetypes.StringType{}.TerraformType(ctx)
+	attrTypes["locality"] = basetypes.StringType{}.TerraformType(ctx)
+	attrTypes["postal_code"] = basetypes.StringType{}.TerraformType(ctx)
+	attrTypes["region"] = basetypes.StringType{}.TerraformType(ctx)
+	attrTypes["street_address"] = basetypes.StringType{}.TerraformType(ctx)
 
 	objectType := tftypes.Object{AttributeTypes: attrTypes}
 
 	switch v.state {
 	case attr.ValueStateKnown:
-		vals := make(map[string]tftypes.Value, 4)
+		vals := make(map[string]tftypes.Value, 6)
 
-		val, err = v.FamilyName.ToTerraformValue(ctx)
+		val, err = v.Country.ToTerraformValue(ctx)
 
 		if err != nil {
 			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
 		}
 
-		vals["family_name"] = val
+		vals["country"] = val
 
-		val, err = v.GivenName.ToTerraformValue(ctx)
+		val, err = v.Formatted.ToTerraformValue(ctx)
 
 		if err != nil {
 			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
 		}
 
-		vals["given_name"] = val
+		vals["formatted"] = val
 
-		val, err = v.MiddleName.ToTerraformValue(ctx)
+		val, err = v.Locality.ToTerraformValue(ctx)
 
 		if err != nil {
 			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
 		}
 
-		vals["middle_name"] = val
+		vals["locality"] = val
 
-		val, err = v.Nickname.ToTerraformValue(ctx)
+		val, err = v.PostalCode.ToTerraformValue(ctx)
 
 		if err != nil {
 			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
 		}
 
-		vals["nickname"] = val
+		vals["postal_code"] = val
+
+		val, err = v.Region.ToTerraformValue(ctx)
+
+		if err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		vals["region"] = val
+
+		val, err = v.StreetAddress.ToTerraformValue(ctx)
+
+		if err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		vals["street_address"] = val
 
 		if err := tftypes.ValidateValue(objectType, vals); err != nil {
 			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
@@ -493,26 +1777,28 @@ func (v ProfileValue) ToTerraformValue(ctx context.Context) (tftypes.Value, erro
 	}
 }
 
-func (v ProfileValue) IsNull() bool {
+func (v AddressValue) IsNull() bool {
 	return v.state == attr.ValueStateNull
 }
 
-func (v ProfileValue) IsUnknown() bool {
+func (v AddressValue) IsUnknown() bool {
 	return v.state == attr.ValueStateUnknown
 }
 
-func (v ProfileValue) String() string {
-	return "ProfileValue"
+func (v AddressValue) String() string {
+	return "AddressValue"
 }
 
-func (v ProfileValue) ToObjectValue(ctx context.Context) (basetypes.ObjectValue, diag.Diagnostics) {
+func (v AddressValue) ToObjectValue(ctx context.Context) (basetypes.ObjectValue, diag.Diagnostics) {
 	var diags diag.Diagnostics
 
 	attributeTypes := map[string]attr.Type{
-		"family_name": basetypes.StringType{},
-		"given_name":  basetypes.StringType{},
-		"middle_name": basetypes.StringType{},
-		"nickname":    basetypes.StringType{},
+		"country":        basetypes.StringType{},
+		"formatted":      basetypes.StringType{},
+		"locality":       basetypes.StringType{},
+		"postal_code":    basetypes.StringType{},
+		"region":         basetypes.StringType{},
+		"street_address": basetypes.StringType{},
 	}
 
 	if v.IsNull() {
@@ -526,17 +1812,19 @@ func (v ProfileValue) ToObjectValue(ctx context.Context) (basetypes.ObjectValue,
 	objVal, diags := types.ObjectValue(
 		attributeTypes,
 		map[string]attr.Value{
-			"family_name": v.FamilyName,
-			"given_name":  v.GivenName,
-			"middle_name": v.MiddleName,
-			"nickname":    v.Nickname,
+			"country":        v.Country,
+			"formatted":      v.Formatted,
+			"locality":       v.Locality,
+			"postal_code":    v.PostalCode,
+			"region":         v.Region,
+			"street_address": v.StreetAddress,
 		})
 
 	return objVal, diags
 }
 
-func (v ProfileValue) Equal(o attr.Value) bool {
-	other, ok := o.(ProfileValue)
+func (v AddressValue) Equal(o attr.Value) bool {
+	other, ok := o.(AddressValue)
 
 	if !ok {
 		return false
@@ -550,38 +1838,48 @@ func (v ProfileValue) Equal(o attr.Value) bool {
 		return true
 	}
 
-	if !v.FamilyName.Equal(other.FamilyName) {
+	if !v.Country.Equal(other.Country) {
 		return false
 	}
 
-	if !v.GivenName.Equal(other.GivenName) {
+	if !v.Formatted.Equal(other.Formatted) {
 		return false
 	}
 
-	if !v.MiddleName.Equal(other.MiddleName) {
+	if !v.Locality.Equal(other.Locality) {
 		return false
 	}
 
-	if !v.Nickname.Equal(other.Nickname) {
+	if !v.PostalCode.Equal(other.PostalCode) {
+		return false
+	}
+
+	if !v.Region.Equal(other.Region) {
+		return false
+	}
+
+	if !v.StreetAddress.Equal(other.StreetAddress) {
 		return false
 	}
 
 	return true
 }
 
-func (v ProfileValue) Type(ctx context.Context) attr.Type {
-	return ProfileType{
+func (v AddressValue) Type(ctx context.Context) attr.Type {
+	return AddressType{
 		basetypes.ObjectType{
 			AttrTypes: v.AttributeTypes(ctx),
 		},
 	}
 }
 
-func (v ProfileValue) AttributeTypes(ctx context.Context) map[string]attr.Type {
+func (v AddressValue) AttributeTypes(ctx context.Context) map[string]attr.Type {
 	return map[string]attr.Type{
-		"family_name": basetypes.StringType{},
-		"given_name":  basetypes.StringType{},
-		"middle_name": basetypes.StringType{},
-		"nickname":    basetypes.StringType{},
+		"country":        basetypes.StringType{},
+		"formatted":      basetypes.StringType{},
+		"locality":       basetypes.StringType{},
+		"postal_code":    basetypes.StringType{},
+		"region":         basetypes.StringType{},
+		"street_address": basetypes.StringType{},
 	}
 }
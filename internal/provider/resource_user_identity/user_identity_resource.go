@@ -0,0 +1,192 @@
+package resource_user_identity
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/Lenstra/terraform-provider-logto/client"
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.ResourceWithImportState = &userIdentityResource{}
+)
+
+func (r *userIdentityResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan UserIdentityModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state, diags := r.link(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *userIdentityResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state UserIdentityModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	userId, target := state.UserId.ValueString(), state.Target.ValueString()
+	user, err := r.client.UserGet(ctx, userId)
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading user", err.Error())
+		return
+	}
+
+	if user == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	identity, found := user.Identities[target]
+	if !found {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	diags = resp.State.Set(ctx, convertToTerraformModel(userId, target, identity))
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *userIdentityResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan UserIdentityModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var config, prior UserIdentityModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &prior)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Linking the identity again replaces the one already linked for the
+	// target, and Logto drops the details that are not sent again.
+	if config.Details.IsNull() {
+		plan.Details = prior.Details
+	}
+
+	state, diags := r.link(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *userIdentityResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state UserIdentityModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.UserIdentityDelete(ctx, state.UserId.ValueString(), state.Target.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error unlinking identity from user", err.Error())
+	}
+}
+
+func (r *userIdentityResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts := strings.Split(req.ID, "/")
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected import identifier",
+			"Expected format: <user_id>/<target>",
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("user_id"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("target"), parts[1])...)
+}
+
+// link links the planned identity to the user and returns the resulting
+// state.
+func (r *userIdentityResource) link(ctx context.Context, plan UserIdentityModel) (UserIdentityModel, diag.Diagnostics) {
+	userId, target := plan.UserId.ValueString(), plan.Target.ValueString()
+	identity, diags := decodePlan(plan)
+	if diags.HasError() {
+		return plan, diags
+	}
+
+	identities, err := r.client.UserIdentityUpdate(ctx, userId, target, identity)
+	if err != nil {
+		diags.AddError("Error linking identity to user", err.Error())
+		return plan, diags
+	}
+
+	linked, found := identities[target]
+	if !found {
+		diags.AddError(
+			"Error linking identity to user",
+			fmt.Sprintf("The identity for target %q is missing from the identities of user %q returned by Logto.", target, userId),
+		)
+		return plan, diags
+	}
+
+	return convertToTerraformModel(userId, target, linked), diags
+}
+
+func decodePlan(plan UserIdentityModel) (*client.IdentityModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	identity := &client.IdentityModel{
+		UserID: plan.IdentityId.ValueString(),
+	}
+
+	if !plan.Details.IsNull() && !plan.Details.IsUnknown() {
+		var details map[string]any
+		if err := json.Unmarshal([]byte(plan.Details.ValueString()), &details); err != nil || details == nil {
+			diags.AddAttributeError(
+				path.Root("details"),
+				"Invalid details",
+				"details must be a JSON encoded object.",
+			)
+			return nil, diags
+		}
+		identity.Details = json.RawMessage(plan.Details.ValueString())
+	}
+
+	return identity, diags
+}
+
+func convertToTerraformModel(userId, target string, identity client.IdentityModel) UserIdentityModel {
+	details := jsontypes.NewNormalizedNull()
+	if len(identity.Details) != 0 && string(identity.Details) != "null" {
+		details = jsontypes.NewNormalizedValue(string(identity.Details))
+	}
+
+	return UserIdentityModel{
+		Id:         types.StringValue(userId + "/" + target),
+		UserId:     types.StringValue(userId),
+		Target:     types.StringValue(target),
+		IdentityId: types.StringValue(identity.UserID),
+		Details:    details,
+	}
+}
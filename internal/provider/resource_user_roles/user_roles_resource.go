@@ -0,0 +1,271 @@
+package resource_user_roles
+
+import (
+	"context"
+	"slices"
+
+	"github.com/Lenstra/terraform-provider-logto/client"
+	"github.com/Lenstra/terraform-provider-logto/internal/provider/roleconflicts"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const (
+	modeAuthoritative = "authoritative"
+	modeAdditive      = "additive"
+)
+
+var (
+	_ resource.ResourceWithImportState = &userRolesResource{}
+	_ resource.ResourceWithModifyPlan  = &userRolesResource{}
+)
+
+func (r *userRolesResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan UserRolesModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state, diags := r.apply(ctx, plan, nil)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *userRolesResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state UserRolesModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	userId := state.UserId.ValueString()
+	user, err := r.client.UserGet(ctx, userId)
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading user", err.Error())
+		return
+	}
+
+	if user == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	current, err := r.roleIds(ctx, userId)
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading role(s) of user", err.Error())
+		return
+	}
+
+	mode := state.Mode.ValueString()
+	if mode == "" {
+		mode = modeAuthoritative
+	}
+
+	roleIds := current
+	if mode == modeAdditive {
+		// Only the roles managed by this resource are compared, so that the
+		// roles granted outside of Terraform do not show up as drift while
+		// the managed roles removed outside of Terraform do.
+		managed, diags := decodeRoleIds(ctx, state.RoleIds)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		roleIds = intersect(managed, current)
+	}
+
+	diags = resp.State.Set(ctx, convertToTerraformModel(userId, mode, roleIds))
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *userRolesResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, prior UserRolesModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &prior)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The roles of the state are only known to be managed when the mode is
+	// unchanged. An imported resource holds every role of the user, switching
+	// it to the additive mode must not remove the roles granted elsewhere.
+	var previous []string
+	if plan.Mode.Equal(prior.Mode) {
+		var diags diag.Diagnostics
+		previous, diags = decodeRoleIds(ctx, prior.RoleIds)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	state, diags := r.apply(ctx, plan, previous)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *userRolesResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state UserRolesModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	userId := state.UserId.ValueString()
+	managed, diags := decodeRoleIds(ctx, state.RoleIds)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	current, err := r.roleIds(ctx, userId)
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading role(s) of user", err.Error())
+		return
+	}
+
+	// Only the roles of the state are removed, whatever the mode, as the
+	// roles granted since the last refresh are not known to be managed.
+	for _, roleId := range intersect(managed, current) {
+		err := r.client.DeleteRolesForUser(ctx, roleId, userId)
+		if err != nil {
+			resp.Diagnostics.AddError("Error when removing role from user", err.Error())
+			return
+		}
+	}
+}
+
+func (r *userRolesResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("user_id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("mode"), modeAuthoritative)...)
+}
+
+// ModifyPlan warns about the roles that are also managed by another resource.
+func (r *userRolesResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan UserRolesModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(roleconflicts.ClaimPlanned(ctx, r.client, "logto_user_roles", roleconflicts.KindUser, plan.UserId, plan.RoleIds)...)
+}
+
+// apply assigns and removes the roles of the user so that they match the
+// plan. previous holds the roles managed before the update, it is used in
+// additive mode to know which roles are no longer managed and must be
+// removed.
+func (r *userRolesResource) apply(ctx context.Context, plan UserRolesModel, previous []string) (UserRolesModel, diag.Diagnostics) {
+	userId, mode := plan.UserId.ValueString(), plan.Mode.ValueString()
+	planned, diags := decodeRoleIds(ctx, plan.RoleIds)
+	if diags.HasError() {
+		return plan, diags
+	}
+
+	current, err := r.roleIds(ctx, userId)
+	if err != nil {
+		diags.AddError("Error reading role(s) of user", err.Error())
+		return plan, diags
+	}
+
+	var toAssign, toRemove []string
+	for _, roleId := range planned {
+		if !slices.Contains(current, roleId) {
+			toAssign = append(toAssign, roleId)
+		}
+	}
+	for _, roleId := range current {
+		if slices.Contains(planned, roleId) {
+			continue
+		}
+		if mode == modeAuthoritative || slices.Contains(previous, roleId) {
+			toRemove = append(toRemove, roleId)
+		}
+	}
+
+	if len(toAssign) != 0 {
+		err = r.client.AssignRolesForUser(ctx, &client.RoleIdsModel{RoleIds: toAssign}, userId)
+		if err != nil {
+			diags.AddError("Error during assignation of role(s) for user", err.Error())
+			return plan, diags
+		}
+	}
+
+	for _, roleId := range toRemove {
+		err = r.client.DeleteRolesForUser(ctx, roleId, userId)
+		if err != nil {
+			diags.AddError("Error when removing role from user", err.Error())
+			return plan, diags
+		}
+	}
+
+	return convertToTerraformModel(userId, mode, planned), diags
+}
+
+// roleIds returns the identifiers of all the roles of the user.
+func (r *userRolesResource) roleIds(ctx context.Context, userId string) ([]string, error) {
+	roleIds := []string{}
+	for role, err := range r.client.UserRolesAll(ctx, userId, nil) {
+		if err != nil {
+			return nil, err
+		}
+		roleIds = append(roleIds, role.ID)
+	}
+	return roleIds, nil
+}
+
+func decodeRoleIds(ctx context.Context, set types.Set) ([]string, diag.Diagnostics) {
+	roleIds := []string{}
+	if set.IsNull() || set.IsUnknown() {
+		return roleIds, nil
+	}
+	diags := set.ElementsAs(ctx, &roleIds, false)
+	return roleIds, diags
+}
+
+func intersect(a, b []string) []string {
+	res := []string{}
+	for _, value := range a {
+		if slices.Contains(b, value) {
+			res = append(res, value)
+		}
+	}
+	return res
+}
+
+func convertToTerraformModel(userId, mode string, roleIds []string) UserRolesModel {
+	roles := make([]attr.Value, 0, len(roleIds))
+	for _, roleId := range roleIds {
+		roles = append(roles, types.StringValue(roleId))
+	}
+
+	return UserRolesModel{
+		Id:      types.StringValue(userId),
+		UserId:  types.StringValue(userId),
+		Mode:    types.StringValue(mode),
+		RoleIds: types.SetValueMust(types.StringType, roles),
+	}
+}
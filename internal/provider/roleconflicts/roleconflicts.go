@@ -0,0 +1,96 @@
+// Package roleconflicts detects the role assignments managed by more than one
+// resource, for example by both the role_ids attribute of logto_user and a
+// logto_role_assignment. Such resources keep undoing the changes of each
+// other so the user is warned about them.
+//
+// The resources claim the assignments they manage while their plan is
+// computed. As all the resources using the same provider configuration share
+// the same client, the claims are recorded per client. Assignments whose
+// member or role is not known yet cannot be claimed, so conflicts are only
+// reported once both sides exist.
+package roleconflicts
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/Lenstra/terraform-provider-logto/client"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const (
+	KindUser        = "user"
+	KindApplication = "application"
+)
+
+type assignment struct {
+	kind     string
+	memberId string
+	roleId   string
+}
+
+var (
+	mu     sync.Mutex
+	claims = map[*client.Client]map[assignment]string{}
+)
+
+// Claim records that owner manages the roles roleIds of the member memberId
+// of the given kind. A warning is returned for every assignment already
+// claimed by another owner.
+func Claim(c *client.Client, owner, kind, memberId string, roleIds []string) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if c == nil || memberId == "" {
+		return diags
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+
+	owners, found := claims[c]
+	if !found {
+		owners = map[assignment]string{}
+		claims[c] = owners
+	}
+
+	for _, roleId := range roleIds {
+		key := assignment{kind: kind, memberId: memberId, roleId: roleId}
+		previous, found := owners[key]
+		if found && previous != owner {
+			diags.AddWarning(
+				"Conflicting role assignment",
+				fmt.Sprintf(
+					"The role %q of the %s %q is managed by both %s and %s. They will keep undoing the changes of each other, the assignment should only be managed in one place.",
+					roleId, kind, memberId, previous, owner,
+				),
+			)
+			continue
+		}
+		owners[key] = owner
+	}
+	return diags
+}
+
+// ClaimPlanned is like Claim for the planned attributes of a resource. It does
+// nothing when the member or its roles are not known yet.
+func ClaimPlanned(ctx context.Context, c *client.Client, owner, kind string, memberId types.String, roleIds types.Set) diag.Diagnostics {
+	if memberId.IsNull() || memberId.IsUnknown() || roleIds.IsNull() || roleIds.IsUnknown() {
+		return nil
+	}
+
+	var elements []types.String
+	diags := roleIds.ElementsAs(ctx, &elements, false)
+	if diags.HasError() {
+		return diags
+	}
+
+	ids := make([]string, 0, len(elements))
+	for _, element := range elements {
+		if !element.IsUnknown() {
+			ids = append(ids, element.ValueString())
+		}
+	}
+	diags.Append(Claim(c, owner, kind, memberId.ValueString(), ids)...)
+	return diags
+}
@@ -7,7 +7,9 @@ import (
 	"context"
 	"flag"
 	"log"
+	"os"
 
+	"github.com/Lenstra/terraform-provider-logto/internal/export"
 	"github.com/Lenstra/terraform-provider-logto/internal/provider/provider_logto"
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
 )
@@ -25,6 +27,15 @@ var (
 )
 
 func main() {
+	// The export subcommand generates the configuration of an existing
+	// tenant instead of serving the provider.
+	if len(os.Args) > 1 && os.Args[1] == "export" {
+		if err := export.Command(context.Background(), os.Args[2:], os.Stderr); err != nil {
+			log.Fatal(err.Error())
+		}
+		return
+	}
+
 	var debugMode bool
 
 	flag.BoolVar(&debugMode, "debug", false, "set to true to run the provider with support for debuggers like delve")
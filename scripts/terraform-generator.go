@@ -1,11 +1,18 @@
 package main
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
 	"log"
 	"os"
+	"slices"
+	"strconv"
 	"strings"
 	"unicode"
 
@@ -44,9 +51,104 @@ func Main() error {
 	if err := generate(input, output); err != nil {
 		return err
 	}
+	if err := markWriteOnly(); err != nil {
+		return err
+	}
 	return generateAdditionalFiles(output)
 }
 
+// writeOnlyAttributes lists the attributes that must never be stored in the
+// state, by generated file. The code specification has no way to declare
+// write-only attributes yet so they are marked after the generation.
+var writeOnlyAttributes = map[string][]string{
+	"internal/provider/resource_user/user_resource_gen.go": {"password"},
+}
+
+// markWriteOnly adds WriteOnly to the schema of the writeOnlyAttributes,
+// right after their Sensitive field. The generation fails when an attribute
+// is not found exactly once or is not sensitive, so that a change of the
+// generated code cannot silently leave it stored in the state.
+func markWriteOnly() error {
+	for path, attributes := range writeOnlyAttributes {
+		content, err := os.ReadFile(path)
+		if err != nil {
+			return err
+		}
+
+		for _, attribute := range attributes {
+			offset, err := writeOnlyOffset(path, content, attribute)
+			if err != nil {
+				return err
+			}
+			content = slices.Concat(content[:offset], []byte("WriteOnly: true,\n"), content[offset:])
+		}
+
+		content, err = format.Source(content)
+		if err != nil {
+			return err
+		}
+		if err := os.WriteFile(path, content, 0o644); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// writeOnlyOffset returns the offset of the line following the Sensitive
+// field of the schema of attribute in the generated file.
+func writeOnlyOffset(path string, content []byte, attribute string) (int, error) {
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, path, content, 0)
+	if err != nil {
+		return 0, err
+	}
+
+	var found []*ast.CompositeLit
+	ast.Inspect(file, func(node ast.Node) bool {
+		pair, ok := node.(*ast.KeyValueExpr)
+		if !ok {
+			return true
+		}
+		key, ok := pair.Key.(*ast.BasicLit)
+		if !ok || key.Kind != token.STRING || key.Value != strconv.Quote(attribute) {
+			return true
+		}
+		if value, ok := pair.Value.(*ast.CompositeLit); ok {
+			found = append(found, value)
+		}
+		return true
+	})
+	if len(found) != 1 {
+		return 0, fmt.Errorf("attribute %q found %d times in %s, expected exactly once", attribute, len(found), path)
+	}
+
+	var sensitive ast.Expr
+	for _, element := range found[0].Elts {
+		pair, ok := element.(*ast.KeyValueExpr)
+		if !ok {
+			continue
+		}
+		switch fmt.Sprint(pair.Key) {
+		case "WriteOnly":
+			return 0, fmt.Errorf("attribute %q of %s is already write-only", attribute, path)
+		case "Sensitive":
+			if value, ok := pair.Value.(*ast.Ident); ok && value.Name == "true" {
+				sensitive = pair
+			}
+		}
+	}
+	if sensitive == nil {
+		return 0, fmt.Errorf("attribute %q of %s must be sensitive to be write-only", attribute, path)
+	}
+
+	end := fset.Position(sensitive.End()).Offset
+	newline := bytes.IndexByte(content[end:], '\n')
+	if newline == -1 {
+		return 0, fmt.Errorf("unexpected end of %s", path)
+	}
+	return end + newline + 1, nil
+}
+
 func generate(input, output string) error {
 	cmd := exec.Command(
 		"tfplugingen-framework",
@@ -76,13 +178,37 @@ func generateAdditionalFiles(output string) error {
 				return err
 			}
 		}
+		if entry.IsDir() && strings.HasPrefix(entry.Name(), "datasource_") {
+			packageName := strings.TrimPrefix(entry.Name(), "datasource_")
+			dataSourceName := toCamelCase(packageName)
+			path := fmt.Sprintf("internal/provider/%s/%s_data_source_impl_gen.go", entry.Name(), packageName)
+			content := []byte(dataSourceTemplate(packageName, dataSourceName))
+			if err := os.WriteFile(path, content, 0o644); err != nil {
+				return err
+			}
+		}
 	}
 	return nil
 }
 
 func template(packageName, resourceName string) string {
 	noImportState := map[string]struct{}{
-		"api_resource_scope": {},
+		"api_resource_scope":      {},
+		"organization_membership": {},
+		"application_secret":      {},
+		"user_identity":           {},
+		"user_roles":              {},
+		"role_assignment":         {},
+		"role":                    {},
+		"role_scopes":             {},
+		"user":                    {},
+	}
+
+	// The resources with an identity can also be imported using the
+	// identity attribute of import blocks.
+	withIdentity := map[string]struct{}{
+		"api_resource": {},
+		"application":  {},
 	}
 
 	skipImportState := false
@@ -90,6 +216,11 @@ func template(packageName, resourceName string) string {
 		skipImportState = true
 	}
 
+	importStatePassthrough := `resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)`
+	if _, found := withIdentity[packageName]; found {
+		importStatePassthrough = `resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("id"), req, resp)`
+	}
+
 	importStateBlock := ""
 
 	varBlock := "_ resource.Resource                = &" + resourceName + "Resource{}\n" +
@@ -101,8 +232,8 @@ func template(packageName, resourceName string) string {
 
 	if !skipImportState {
 		importStateBlock = fmt.Sprintf(`func (r *%[1]sResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
-}`, resourceName)
+	%[2]s
+}`, resourceName, importStatePassthrough)
 
 		varBlock += "\n\t_ resource.ResourceWithImportState = &" + resourceName + "Resource{}"
 
@@ -153,6 +284,51 @@ func (r *%[1]sResource) Configure(_ context.Context, req resource.ConfigureReque
 `, resourceName, toPascalCase(packageName), packageName, imports, varBlock, importStateBlock)
 }
 
+func dataSourceTemplate(packageName, dataSourceName string) string {
+	return fmt.Sprintf(`// Code generated by terraform-generator DO NOT EDIT.
+package datasource_%[3]s
+
+import (
+	"context"
+	"github.com/Lenstra/terraform-provider-logto/client"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &%[1]sDataSource{}
+	_ datasource.DataSourceWithConfigure = &%[1]sDataSource{}
+)
+
+type %[1]sDataSource struct {
+	client *client.Client
+}
+
+func %[2]sDataSource() datasource.DataSource {
+	return &%[1]sDataSource{}
+}
+
+func (d *%[1]sDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_%[3]s"
+}
+
+func (d *%[1]sDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = %[2]sDataSourceSchema(ctx)
+}
+
+func (d *%[1]sDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		return
+	}
+	d.client = client
+}
+`, dataSourceName, toPascalCase(packageName), packageName)
+}
+
 func toCamelCase(s string) string {
 	parts := strings.FieldsFunc(s, func(r rune) bool {
 		return r == '_' || r == '-' || unicode.IsSpace(r)
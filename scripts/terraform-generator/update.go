@@ -51,16 +51,50 @@ func Update(spec, extra *spec.Specification) {
 				resource.Schema.Attributes,
 				extraResource.Schema.Attributes...,
 			)
+			delete(resources, resource.Name)
+		}
+	}
+	// resources that do not map to a single Logto endpoint are entirely
+	// described in our extra conf
+	for _, r := range extra.Resources {
+		if _, found := resources[r.Name]; found {
+			spec.Resources = append(spec.Resources, r)
 		}
 	}
 	for _, datasource := range spec.DataSources {
 		extraDatasource, found := datasources[datasource.Name]
 		if found {
-			datasource.Schema.Attributes = append(
+			datasource.Schema.Attributes = mergeDataSourceAttributes(
 				datasource.Schema.Attributes,
-				extraDatasource.Schema.Attributes...,
+				extraDatasource.Schema.Attributes,
 			)
+			delete(datasources, datasource.Name)
+		}
+	}
+	for _, d := range extra.DataSources {
+		if _, found := datasources[d.Name]; found {
+			spec.DataSources = append(spec.DataSources, d)
 		}
 	}
+}
 
+// mergeDataSourceAttributes adds the extra attributes to those generated from
+// the OpenAPI spec. Unlike for resources, an extra attribute replaces the
+// generated one with the same name: this is how the lookup attributes of the
+// data sources, e.g. the name of a role, are made optional.
+func mergeDataSourceAttributes(attributes, extra datasource.Attributes) datasource.Attributes {
+	for _, e := range extra {
+		replaced := false
+		for i, a := range attributes {
+			if a.Name == e.Name {
+				attributes[i] = e
+				replaced = true
+				break
+			}
+		}
+		if !replaced {
+			attributes = append(attributes, e)
+		}
+	}
+	return attributes
 }